@@ -0,0 +1,313 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/diegohordi/nullable"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type accessor[T any] interface {
+	Get() (T, bool)
+	ValueOr(T) T
+	MustGet() T
+	Ptr() *T
+}
+
+func testAccessors[T any](t *testing.T, null, valid accessor[T], v, def T) {
+	t.Helper()
+	if got, ok := null.Get(); ok || !reflect.DeepEqual(got, *new(T)) {
+		t.Errorf("Get() got = %v, %v, want zero value, false", got, ok)
+	}
+	if got, ok := valid.Get(); !ok || !reflect.DeepEqual(got, v) {
+		t.Errorf("Get() got = %v, %v, want %v, true", got, ok, v)
+	}
+	if got := null.ValueOr(def); !reflect.DeepEqual(got, def) {
+		t.Errorf("ValueOr() got = %v, want %v", got, def)
+	}
+	if got := valid.ValueOr(def); !reflect.DeepEqual(got, v) {
+		t.Errorf("ValueOr() got = %v, want %v", got, v)
+	}
+	if got := null.Ptr(); got != nil {
+		t.Errorf("Ptr() got = %v, want nil", got)
+	}
+	if got := valid.Ptr(); got == nil || !reflect.DeepEqual(*got, v) {
+		t.Errorf("Ptr() got = %v, want %v", got, v)
+	}
+	if got := valid.MustGet(); !reflect.DeepEqual(got, v) {
+		t.Errorf("MustGet() got = %v, want %v", got, v)
+	}
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, nullable.ErrNull) {
+			t.Errorf("MustGet() panic = %v, want %v", err, nullable.ErrNull)
+		}
+	}()
+	null.MustGet()
+}
+
+func TestAccessors(t *testing.T) {
+	t.Run("Bool", func(t *testing.T) {
+		testAccessors[bool](t, nullable.Bool{}, nullable.BoolFrom(true), true, false)
+	})
+	t.Run("Int64", func(t *testing.T) {
+		testAccessors[int64](t, nullable.Int64{}, nullable.Int64From(100), 100, -1)
+	})
+	t.Run("Uint8", func(t *testing.T) {
+		testAccessors[uint8](t, nullable.Uint8{}, nullable.Uint8From(100), 100, 1)
+	})
+	t.Run("Float64", func(t *testing.T) {
+		testAccessors[float64](t, nullable.Float64{}, nullable.Float64From(1.5), 1.5, -1)
+	})
+	t.Run("String", func(t *testing.T) {
+		testAccessors[string](t, nullable.String{}, nullable.StringFrom("test"), "test", "default")
+	})
+	t.Run("String with policy", func(t *testing.T) {
+		empty := nullable.String{NullString: nullable.StringFrom("").NullString, Policy: nullable.EmptyAsNull}
+		testAccessors[string](t, empty, nullable.StringFrom("test"), "test", "default")
+	})
+	t.Run("Time", func(t *testing.T) {
+		testAccessors[time.Time](t, nullable.Time{}, nullable.TimeFrom(timeRef), timeRef, time.Now())
+	})
+	t.Run("UnixTime", func(t *testing.T) {
+		testAccessors[time.Time](t, nullable.UnixTime{}, nullable.UnixTimeFrom(timeRef), timeRef, time.Now())
+	})
+	t.Run("Byte", func(t *testing.T) {
+		testAccessors[byte](t, nullable.Byte{}, nullable.ByteFrom('a'), 'a', 'b')
+	})
+	t.Run("Bytes", func(t *testing.T) {
+		testAccessors[[]byte](t, nullable.Bytes{}, nullable.BytesFrom([]byte("test")), []byte("test"), []byte{})
+	})
+	t.Run("UUID", func(t *testing.T) {
+		id := [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+		testAccessors[[16]byte](t, nullable.UUID{}, nullable.UUIDFrom(id), id, [16]byte{})
+	})
+	t.Run("Int64Array", func(t *testing.T) {
+		elems := []nullable.Int64{nullable.Int64From(1), {}}
+		testAccessors[[]nullable.Int64](t, nullable.Int64Array{}, nullable.ArrayFrom(elems), elems, nil)
+	})
+	t.Run("Int64Range", func(t *testing.T) {
+		r := nullable.Range[nullable.Int64]{Lower: nullable.Int64From(1), LowerInclusive: true}
+		testAccessors[nullable.Range[nullable.Int64]](t, nullable.Int64Range{}, nullable.RangeFrom(r), r,
+			nullable.Range[nullable.Int64]{Empty: true})
+	})
+	t.Run("Int", func(t *testing.T) {
+		testAccessors[int](t, nullable.Int{}, nullable.IntFrom(100), 100, -1)
+	})
+	t.Run("Int8", func(t *testing.T) {
+		testAccessors[int8](t, nullable.Int8{}, nullable.Int8From(100), 100, -1)
+	})
+	t.Run("Int16", func(t *testing.T) {
+		testAccessors[int16](t, nullable.Int16{}, nullable.Int16From(100), 100, -1)
+	})
+	t.Run("Int32", func(t *testing.T) {
+		testAccessors[int32](t, nullable.Int32{}, nullable.Int32From(100), 100, -1)
+	})
+	t.Run("Int64String", func(t *testing.T) {
+		testAccessors[int64](t, nullable.Int64String{}, nullable.Int64StringFrom(100), 100, -1)
+	})
+	t.Run("Uint16", func(t *testing.T) {
+		testAccessors[uint16](t, nullable.Uint16{}, nullable.Uint16From(100), 100, 1)
+	})
+	t.Run("Uint32", func(t *testing.T) {
+		testAccessors[uint32](t, nullable.Uint32{}, nullable.Uint32From(100), 100, 1)
+	})
+	t.Run("Uint64", func(t *testing.T) {
+		testAccessors[uint64](t, nullable.Uint64{}, nullable.Uint64From(100), 100, 1)
+	})
+	t.Run("Uint64String", func(t *testing.T) {
+		testAccessors[uint64](t, nullable.Uint64String{}, nullable.Uint64StringFrom(100), 100, 1)
+	})
+	t.Run("Float32", func(t *testing.T) {
+		testAccessors[float32](t, nullable.Float32{}, nullable.Float32From(1.5), 1.5, -1)
+	})
+	t.Run("Decimal", func(t *testing.T) {
+		d := big.NewRat(3, 2)
+		testAccessors[*big.Rat](t, nullable.Decimal{}, nullable.DecimalFrom(d), d, new(big.Rat))
+	})
+	t.Run("DecimalString", func(t *testing.T) {
+		d := big.NewRat(3, 2)
+		testAccessors[*big.Rat](t, nullable.DecimalString{}, nullable.DecimalStringFrom(d), d, new(big.Rat))
+	})
+	t.Run("Duration", func(t *testing.T) {
+		testAccessors[time.Duration](t, nullable.Duration{}, nullable.DurationFrom(durationRef), durationRef, time.Second)
+	})
+	t.Run("Date", func(t *testing.T) {
+		testAccessors[nullable.CivilDate](t, nullable.Date{}, nullable.DateFrom(dateRef), dateRef, nullable.CivilDate{})
+	})
+	t.Run("TimeOfDay", func(t *testing.T) {
+		tod := nullable.CivilTime{Hour: 12, Minute: 10}
+		testAccessors[nullable.CivilTime](t, nullable.TimeOfDay{}, nullable.TimeOfDayFrom(tod), tod, nullable.CivilTime{})
+	})
+	t.Run("UnixMilliTime", func(t *testing.T) {
+		testAccessors[time.Time](t, nullable.UnixMilliTime{}, nullable.UnixMilliTimeFrom(timeRef), timeRef, time.Now())
+	})
+	t.Run("IP", func(t *testing.T) {
+		ip := netip.MustParseAddr("10.0.0.1")
+		testAccessors[netip.Addr](t, nullable.IP{}, nullable.IPFrom(ip), ip, netip.Addr{})
+	})
+	t.Run("Prefix", func(t *testing.T) {
+		p := netip.MustParsePrefix("10.0.0.0/8")
+		testAccessors[netip.Prefix](t, nullable.Prefix{}, nullable.PrefixFrom(p), p, netip.Prefix{})
+	})
+	t.Run("HardwareAddr", func(t *testing.T) {
+		mac := net.HardwareAddr{0x00, 0x1a, 0x2b, 0x3c, 0x4d, 0x5e}
+		testAccessors[net.HardwareAddr](t, nullable.HardwareAddr{}, nullable.HardwareAddrFrom(mac), mac, nil)
+	})
+	t.Run("URL", func(t *testing.T) {
+		u := url.URL{Scheme: "https", Host: "example.com"}
+		testAccessors[url.URL](t, nullable.URL{}, nullable.URLFrom(u), u, url.URL{})
+	})
+	t.Run("JSON", func(t *testing.T) {
+		p := payload{Name: "nullable"}
+		testAccessors[payload](t, nullable.JSON[payload]{}, nullable.JSONFrom(p), p, payload{})
+	})
+	t.Run("RawJSON", func(t *testing.T) {
+		raw := json.RawMessage(`[1,2]`)
+		testAccessors[json.RawMessage](t, nullable.RawJSON{}, nullable.RawJSONFrom(raw), raw, nil)
+	})
+	t.Run("OptionalString", func(t *testing.T) {
+		testAccessors[string](t, nullable.OptionalString{}, *nullable.NewOptionalString("test"), "test", "default")
+	})
+	t.Run("Of", func(t *testing.T) {
+		testAccessors[status](t, nullable.Of[status]{}, nullable.From(status("active")), "active", "inactive")
+	})
+}
+
+func TestFromPtr(t *testing.T) {
+	i, s, tm, st := int64(100), "test", timeRef, status("active")
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{
+			name: "should return a null int64",
+			got:  nullable.Int64FromPtr(nil),
+			want: nullable.Int64{},
+		},
+		{
+			name: "should return the given int64",
+			got:  nullable.Int64FromPtr(&i),
+			want: *nullable.NewInt64(100),
+		},
+		{
+			name: "should return a null string",
+			got:  nullable.StringFromPtr(nil),
+			want: nullable.String{},
+		},
+		{
+			name: "should return the given string",
+			got:  nullable.StringFromPtr(&s),
+			want: *nullable.NewString("test"),
+		},
+		{
+			name: "should return the given time",
+			got:  nullable.TimeFromPtr(&tm),
+			want: *nullable.NewTime(timeRef),
+		},
+		{
+			name: "should return a null value",
+			got:  nullable.FromPtr[status](nil),
+			want: nullable.Of[status]{},
+		},
+		{
+			name: "should return the given value",
+			got:  nullable.FromPtr(&st),
+			want: *nullable.New(status("active")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("FromPtr() got = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// testFromPtr checks that fromPtr returns null for nil, and the same value as
+// from otherwise.
+func testFromPtr[T any, N any](t *testing.T, fromPtr func(*T) N, from func(T) N, v T) {
+	t.Helper()
+	if got := fromPtr(nil); !reflect.DeepEqual(got, *new(N)) {
+		t.Errorf("FromPtr() got = %v, want null", got)
+	}
+	if got, want := fromPtr(&v), from(v); !reflect.DeepEqual(got, want) {
+		t.Errorf("FromPtr() got = %v, want %v", got, want)
+	}
+}
+
+func TestFromPtr_Types(t *testing.T) {
+	t.Run("Bool", func(t *testing.T) { testFromPtr(t, nullable.BoolFromPtr, nullable.BoolFrom, true) })
+	t.Run("Byte", func(t *testing.T) { testFromPtr(t, nullable.ByteFromPtr, nullable.ByteFrom, 'a') })
+	t.Run("Bytes", func(t *testing.T) { testFromPtr(t, nullable.BytesFromPtr, nullable.BytesFrom, []byte("hi")) })
+	t.Run("Date", func(t *testing.T) { testFromPtr(t, nullable.DateFromPtr, nullable.DateFrom, dateRef) })
+	t.Run("Decimal", func(t *testing.T) {
+		testFromPtr(t, nullable.DecimalFromPtr, nullable.DecimalFrom, big.NewRat(3, 2))
+	})
+	t.Run("DecimalString", func(t *testing.T) {
+		testFromPtr(t, nullable.DecimalStringFromPtr, nullable.DecimalStringFrom, big.NewRat(3, 2))
+	})
+	t.Run("Duration", func(t *testing.T) {
+		testFromPtr(t, nullable.DurationFromPtr, nullable.DurationFrom, durationRef)
+	})
+	t.Run("Float32", func(t *testing.T) { testFromPtr(t, nullable.Float32FromPtr, nullable.Float32From, 1.5) })
+	t.Run("Float64", func(t *testing.T) { testFromPtr(t, nullable.Float64FromPtr, nullable.Float64From, 1.5) })
+	t.Run("HardwareAddr", func(t *testing.T) {
+		mac := net.HardwareAddr{0x00, 0x1a, 0x2b, 0x3c, 0x4d, 0x5e}
+		testFromPtr(t, nullable.HardwareAddrFromPtr, nullable.HardwareAddrFrom, mac)
+	})
+	t.Run("Int", func(t *testing.T) { testFromPtr(t, nullable.IntFromPtr, nullable.IntFrom, 100) })
+	t.Run("Int8", func(t *testing.T) { testFromPtr(t, nullable.Int8FromPtr, nullable.Int8From, 100) })
+	t.Run("Int16", func(t *testing.T) { testFromPtr(t, nullable.Int16FromPtr, nullable.Int16From, 100) })
+	t.Run("Int32", func(t *testing.T) { testFromPtr(t, nullable.Int32FromPtr, nullable.Int32From, 100) })
+	t.Run("Int64String", func(t *testing.T) {
+		testFromPtr(t, nullable.Int64StringFromPtr, nullable.Int64StringFrom, 100)
+	})
+	t.Run("IP", func(t *testing.T) {
+		testFromPtr(t, nullable.IPFromPtr, nullable.IPFrom, netip.MustParseAddr("10.0.0.1"))
+	})
+	t.Run("Prefix", func(t *testing.T) {
+		testFromPtr(t, nullable.PrefixFromPtr, nullable.PrefixFrom, netip.MustParsePrefix("10.0.0.0/8"))
+	})
+	t.Run("JSON", func(t *testing.T) {
+		testFromPtr(t, nullable.JSONFromPtr[payload], nullable.JSONFrom[payload], payload{Name: "nullable"})
+	})
+	t.Run("RawJSON", func(t *testing.T) {
+		testFromPtr(t, nullable.RawJSONFromPtr, nullable.RawJSONFrom, json.RawMessage(`[1,2]`))
+	})
+	t.Run("TimeOfDay", func(t *testing.T) {
+		testFromPtr(t, nullable.TimeOfDayFromPtr, nullable.TimeOfDayFrom, nullable.CivilTime{Hour: 12, Minute: 10})
+	})
+	t.Run("Uint8", func(t *testing.T) { testFromPtr(t, nullable.Uint8FromPtr, nullable.Uint8From, 100) })
+	t.Run("Uint16", func(t *testing.T) { testFromPtr(t, nullable.Uint16FromPtr, nullable.Uint16From, 100) })
+	t.Run("Uint32", func(t *testing.T) { testFromPtr(t, nullable.Uint32FromPtr, nullable.Uint32From, 100) })
+	t.Run("Uint64", func(t *testing.T) { testFromPtr(t, nullable.Uint64FromPtr, nullable.Uint64From, 100) })
+	t.Run("Uint64String", func(t *testing.T) {
+		testFromPtr(t, nullable.Uint64StringFromPtr, nullable.Uint64StringFrom, 100)
+	})
+	t.Run("UnixTime", func(t *testing.T) {
+		testFromPtr(t, nullable.UnixTimeFromPtr, nullable.UnixTimeFrom, timeRef)
+	})
+	t.Run("UnixMilliTime", func(t *testing.T) {
+		testFromPtr(t, nullable.UnixMilliTimeFromPtr, nullable.UnixMilliTimeFrom, timeRef)
+	})
+	t.Run("URL", func(t *testing.T) {
+		testFromPtr(t, nullable.URLFromPtr, nullable.URLFrom, url.URL{Scheme: "https", Host: "example.com"})
+	})
+	t.Run("UUID", func(t *testing.T) { testFromPtr(t, nullable.UUIDFromPtr, nullable.UUIDFrom, uuidRef) })
+	t.Run("Int64Array", func(t *testing.T) {
+		elems := []nullable.Int64{nullable.Int64From(1), {}}
+		testFromPtr(t, nullable.ArrayFromPtr[nullable.Int64], nullable.ArrayFrom[nullable.Int64], elems)
+	})
+	t.Run("Int64Range", func(t *testing.T) {
+		r := nullable.Range[nullable.Int64]{Lower: nullable.Int64From(1), LowerInclusive: true}
+		testFromPtr(t, nullable.RangeFromPtr[int64, nullable.Int64], nullable.RangeFrom[int64, nullable.Int64], r)
+	})
+}
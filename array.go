@@ -0,0 +1,355 @@
+package nullable
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errMultidimensionalArray = errors.New("multidimensional arrays are not supported")
+
+type arrayElement[E any] interface {
+	*E
+	sql.Scanner
+}
+
+// Array holds the nullable elements of a one-dimensional Postgres array, such
+// as integer[] or text[]. It is scanned from and sent to the database as an
+// array literal, like {1,NULL,3}, and is encoded as a JSON array, where null
+// elements are encoded as null. The text and XML forms are array literals as
+// well.
+type Array[E driver.Valuer, P arrayElement[E]] struct {
+	Elements []E
+	Valid    bool
+	Policy   NullPolicy
+}
+
+type (
+	// Int64Array is a nullable Postgres bigint[] array.
+	Int64Array = Array[Int64, *Int64]
+	// StringArray is a nullable Postgres text[] array.
+	StringArray = Array[String, *String]
+	// BoolArray is a nullable Postgres boolean[] array.
+	BoolArray = Array[Bool, *Bool]
+	// Float64Array is a nullable Postgres double precision[] array.
+	Float64Array = Array[Float64, *Float64]
+	// TimeArray is a nullable Postgres timestamptz[] array.
+	TimeArray = Array[Time, *Time]
+)
+
+func NewArray[E driver.Valuer, P arrayElement[E]](v []E) *Array[E, P] {
+	return &Array[E, P]{
+		Elements: v,
+		Valid:    true,
+	}
+}
+
+func ArrayFrom[E driver.Valuer, P arrayElement[E]](v []E) Array[E, P] {
+	return *NewArray[E, P](v)
+}
+
+func ArrayFromPtr[E driver.Valuer, P arrayElement[E]](v *[]E) Array[E, P] {
+	if v == nil {
+		return Array[E, P]{}
+	}
+	return ArrayFrom[E, P](*v)
+}
+
+func (n *Array[E, P]) codec() *arrayCodec[E, P] {
+	return &arrayCodec[E, P]{&n.Elements}
+}
+
+func (n Array[E, P]) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Array[E, P]) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Elements)
+}
+
+func (n Array[E, P]) Get() ([]E, bool) {
+	return get(n.Elements, n.valid())
+}
+
+func (n Array[E, P]) ValueOr(v []E) []E {
+	return valueOr(n.Elements, n.valid(), v)
+}
+
+func (n Array[E, P]) MustGet() []E {
+	return mustGet(n.Elements, n.valid())
+}
+
+func (n Array[E, P]) Ptr() *[]E {
+	return ptr(n.Elements, n.valid())
+}
+
+func (n *Array[E, P]) Scan(value interface{}) error {
+	return scanValue(value, &n.Elements, &n.Valid, n.policy(), n.codec().convert)
+}
+
+func (n Array[E, P]) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return n.codec().value()
+}
+
+func (n Array[E, P]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.codec(), n.valid())
+}
+
+func (n *Array[E, P]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, n.codec(), &n.Valid, n.policy())
+}
+
+func (n Array[E, P]) MarshalText() ([]byte, error) {
+	return marshalText(n.codec(), n.valid())
+}
+
+func (n *Array[E, P]) UnmarshalText(text []byte) error {
+	return unmarshalText(text, n.codec(), &n.Valid, n.policy())
+}
+
+func (n Array[E, P]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.codec(), n.valid())
+}
+
+func (n *Array[E, P]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, n.codec(), &n.Valid, n.policy())
+}
+
+func (n Array[E, P]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.codec(), n.valid())
+}
+
+func (n *Array[E, P]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, n.codec(), &n.Valid, n.policy())
+}
+
+// arrayCodec parses and formats the elements of an array, scanning the text
+// of each element into E.
+type arrayCodec[E driver.Valuer, P arrayElement[E]] struct {
+	elems *[]E
+}
+
+func (c arrayCodec[E, P]) IsZero() bool {
+	return *c.elems == nil
+}
+
+func (c arrayCodec[E, P]) MarshalText() ([]byte, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, e := range *c.elems {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		v, err := e.Value()
+		if err != nil {
+			return nil, err
+		}
+		if err := formatArrayElement(&b, v); err != nil {
+			return nil, err
+		}
+	}
+	b.WriteByte('}')
+	return []byte(b.String()), nil
+}
+
+func (c *arrayCodec[E, P]) UnmarshalText(text []byte) error {
+	texts, err := parseArray(string(text))
+	if err != nil {
+		return err
+	}
+	elems := make([]E, len(texts))
+	for i, t := range texts {
+		var v interface{}
+		if t != nil {
+			v = *t
+		}
+		if err := P(&elems[i]).Scan(v); err != nil {
+			return err
+		}
+	}
+	*c.elems = elems
+	return nil
+}
+
+func (c arrayCodec[E, P]) MarshalJSON() ([]byte, error) {
+	if *c.elems == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(*c.elems)
+}
+
+func (c *arrayCodec[E, P]) UnmarshalJSON(data []byte) error {
+	var elems []E
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	*c.elems = elems
+	return nil
+}
+
+func (c arrayCodec[E, P]) value() (driver.Value, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+func (c arrayCodec[E, P]) convert(value interface{}) ([]E, error) {
+	var elems []E
+	var err error
+	switch src := value.(type) {
+	case string:
+		err = (&arrayCodec[E, P]{&elems}).UnmarshalText([]byte(src))
+	case []byte:
+		err = (&arrayCodec[E, P]{&elems}).UnmarshalText(src)
+	default:
+		err = fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type %T", value, elems)
+	}
+	return elems, err
+}
+
+// parseArray parses a one-dimensional array literal, returning the text of
+// each element, or nil for NULL elements.
+func parseArray(s string) ([]*string, error) {
+	text := s
+	if strings.HasPrefix(text, "[") {
+		// Skip the dimensions decoration, as in [0:2]={1,2,3}.
+		if i := strings.Index(text, "="); i > 0 {
+			text = text[i+1:]
+		}
+	}
+	if len(text) < 2 || text[0] != '{' || text[len(text)-1] != '}' {
+		return nil, fmt.Errorf("nullable: invalid array %q", s)
+	}
+	text = text[1 : len(text)-1]
+	elems := make([]*string, 0)
+	if strings.TrimSpace(text) == "" {
+		return elems, nil
+	}
+	for i := 0; ; {
+		for i < len(text) && text[i] == ' ' {
+			i++
+		}
+		var (
+			b      strings.Builder
+			quoted bool
+		)
+		if i < len(text) && text[i] == '{' {
+			return nil, fmt.Errorf("nullable: invalid array %q: %w", s, errMultidimensionalArray)
+		}
+		if i < len(text) && text[i] == '"' {
+			quoted = true
+			i++
+			for ; i < len(text) && text[i] != '"'; i++ {
+				if text[i] == '\\' {
+					i++
+				}
+				if i < len(text) {
+					b.WriteByte(text[i])
+				}
+			}
+			if i >= len(text) {
+				return nil, fmt.Errorf("nullable: invalid array %q: unterminated quoted element", s)
+			}
+			i++
+			for i < len(text) && text[i] == ' ' {
+				i++
+			}
+		} else {
+			for ; i < len(text) && text[i] != ','; i++ {
+				if text[i] == '"' || text[i] == '{' || text[i] == '}' {
+					return nil, fmt.Errorf("nullable: invalid array %q", s)
+				}
+				if text[i] == '\\' && i+1 < len(text) {
+					i++
+				}
+				b.WriteByte(text[i])
+			}
+		}
+		elem := b.String()
+		if !quoted {
+			elem = strings.TrimRight(elem, " ")
+		}
+		switch {
+		case !quoted && strings.EqualFold(elem, "NULL"):
+			elems = append(elems, nil)
+		case !quoted && elem == "":
+			return nil, fmt.Errorf("nullable: invalid array %q: empty element", s)
+		default:
+			elems = append(elems, &elem)
+		}
+		if i >= len(text) {
+			return elems, nil
+		}
+		if text[i] != ',' {
+			return nil, fmt.Errorf("nullable: invalid array %q", s)
+		}
+		i++
+	}
+}
+
+func formatArrayElement(b *strings.Builder, v driver.Value) error {
+	if v == nil {
+		b.WriteString("NULL")
+		return nil
+	}
+	text, textual, err := elementText(v)
+	if err != nil {
+		return err
+	}
+	if textual {
+		quoteArrayElement(b, text)
+	} else {
+		b.WriteString(text)
+	}
+	return nil
+}
+
+// elementText returns the text of a non-null element of an array or range
+// literal, and whether it is textual, in which case it may need quoting.
+func elementText(v driver.Value) (string, bool, error) {
+	switch v := v.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10), false, nil
+	case float64:
+		switch {
+		case math.IsInf(v, 1):
+			return "Infinity", false, nil
+		case math.IsInf(v, -1):
+			return "-Infinity", false, nil
+		}
+		return strconv.FormatFloat(v, 'g', -1, 64), false, nil
+	case bool:
+		if v {
+			return "t", false, nil
+		}
+		return "f", false, nil
+	case string:
+		return v, true, nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), true, nil
+	}
+	return "", false, fmt.Errorf("nullable: cannot format %T as an array or range element", v)
+}
+
+func quoteArrayElement(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+}
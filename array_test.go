@@ -0,0 +1,304 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestArray_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []byte
+	}{
+		{
+			name:  "should return null",
+			value: nullable.Int64Array{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should return an empty array",
+			value: *nullable.NewArray([]nullable.Int64(nil)),
+			want:  []byte("[]"),
+		},
+		{
+			name:  "should return an array with null elements",
+			value: *nullable.NewArray([]nullable.Int64{nullable.Int64From(1), {}, nullable.Int64From(3)}),
+			want:  []byte("[1,null,3]"),
+		},
+		{
+			name:  "should return a string array",
+			value: *nullable.NewArray([]nullable.String{nullable.StringFrom("a"), {}}),
+			want:  []byte(`["a",null]`),
+		},
+		{
+			name: "should marshal the given array from a struct",
+			value: &struct {
+				ID    int                `json:"id"`
+				Value nullable.BoolArray `json:"value"`
+			}{
+				ID:    100,
+				Value: *nullable.NewArray([]nullable.Bool{nullable.BoolFrom(true), {}}),
+			},
+			want: []byte(`{"id":100,"value":[true,null]}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArray_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    nullable.Float64Array
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			data: []byte("null"),
+			want: nullable.Float64Array{},
+		},
+		{
+			name: "should unmarshal an empty array",
+			data: []byte("[]"),
+			want: *nullable.NewArray([]nullable.Float64{}),
+		},
+		{
+			name: "should unmarshal an array with null elements",
+			data: []byte("[1.5,null]"),
+			want: *nullable.NewArray([]nullable.Float64{nullable.Float64From(1.5), {}}),
+		},
+		{
+			name:    "should return an error due to an invalid element",
+			data:    []byte(`[1.5,"a"]`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			data:    []byte(`1.5`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Float64Array
+			err := json.Unmarshal(tt.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestArray_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		dest    sql.Scanner
+		value   interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:  "should return a null array",
+			dest:  &nullable.Int64Array{},
+			value: nil,
+			want:  &nullable.Int64Array{},
+		},
+		{
+			name:  "should scan an empty array",
+			dest:  &nullable.Int64Array{},
+			value: []byte("{}"),
+			want:  nullable.NewArray([]nullable.Int64{}),
+		},
+		{
+			name:  "should scan an array with null elements",
+			dest:  &nullable.Int64Array{},
+			value: []byte("{1,NULL,-3}"),
+			want:  nullable.NewArray([]nullable.Int64{nullable.Int64From(1), {}, nullable.Int64From(-3)}),
+		},
+		{
+			name:  "should scan an array with dimensions",
+			dest:  &nullable.Int64Array{},
+			value: "[0:1]={1,2}",
+			want:  nullable.NewArray([]nullable.Int64{nullable.Int64From(1), nullable.Int64From(2)}),
+		},
+		{
+			name:  "should scan quoted and escaped elements",
+			dest:  &nullable.StringArray{},
+			value: `{a,"NULL",NULL,"b c","d,e","f\"g","h\\i",""}`,
+			want: nullable.NewArray([]nullable.String{
+				nullable.StringFrom("a"),
+				nullable.StringFrom("NULL"),
+				{},
+				nullable.StringFrom("b c"),
+				nullable.StringFrom("d,e"),
+				nullable.StringFrom(`f"g`),
+				nullable.StringFrom(`h\i`),
+				nullable.StringFrom(""),
+			}),
+		},
+		{
+			name:  "should scan a boolean array",
+			dest:  &nullable.BoolArray{},
+			value: "{t,f,NULL}",
+			want:  nullable.NewArray([]nullable.Bool{nullable.BoolFrom(true), nullable.BoolFrom(false), {}}),
+		},
+		{
+			name:  "should scan a float array",
+			dest:  &nullable.Float64Array{},
+			value: "{1.5,Infinity,NULL}",
+			want: nullable.NewArray([]nullable.Float64{
+				nullable.Float64From(1.5),
+				nullable.Float64From(math.Inf(1)),
+				{},
+			}),
+		},
+		{
+			name:  "should scan a timestamptz array",
+			dest:  &nullable.TimeArray{},
+			value: `{"2021-01-02 03:04:05.5+02",NULL}`,
+			want: nullable.NewArray([]nullable.Time{
+				nullable.TimeFrom(time.Date(2021, 1, 2, 3, 4, 5, 5e8, time.FixedZone("", 2*60*60))),
+				{},
+			}),
+		},
+		{
+			name:  "should scan a timestamptz array with offsets in seconds",
+			dest:  &nullable.TimeArray{},
+			value: `{"1900-01-01 00:00:00+05:53:28"}`,
+			want: nullable.NewArray([]nullable.Time{
+				nullable.TimeFrom(time.Date(1900, 1, 1, 0, 0, 0, 0, time.FixedZone("", 5*60*60+53*60+28))),
+			}),
+		},
+		{
+			name:    "should return an error due to an invalid element",
+			dest:    &nullable.Int64Array{},
+			value:   "{1,a}",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to a multidimensional array",
+			dest:    &nullable.Int64Array{},
+			value:   "{{1,2},{3,4}}",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unterminated element",
+			dest:    &nullable.StringArray{},
+			value:   `{"a}`,
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an invalid array",
+			dest:    &nullable.StringArray{},
+			value:   "a,b",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unsupported type",
+			dest:    &nullable.StringArray{},
+			value:   int64(1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.dest.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(tt.dest, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", tt.dest, tt.want)
+			}
+		})
+	}
+}
+
+func TestArray_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value driver.Valuer
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.StringArray{},
+			want:  nil,
+		},
+		{
+			name:  "should return an empty array",
+			value: *nullable.NewArray([]nullable.String(nil)),
+			want:  "{}",
+		},
+		{
+			name:  "should return an array with null elements",
+			value: *nullable.NewArray([]nullable.Int64{nullable.Int64From(1), {}}),
+			want:  "{1,NULL}",
+		},
+		{
+			name: "should return quoted and escaped elements",
+			value: *nullable.NewArray([]nullable.String{
+				nullable.StringFrom("NULL"),
+				{},
+				nullable.StringFrom(`a "b" \c`),
+				nullable.StringFrom(""),
+			}),
+			want: `{"NULL",NULL,"a \"b\" \\c",""}`,
+		},
+		{
+			name:  "should return a boolean array",
+			value: *nullable.NewArray([]nullable.Bool{nullable.BoolFrom(true), nullable.BoolFrom(false)}),
+			want:  "{t,f}",
+		},
+		{
+			name:  "should return a float array",
+			value: *nullable.NewArray([]nullable.Float64{nullable.Float64From(0.1), nullable.Float64From(math.Inf(-1))}),
+			want:  "{0.1,-Infinity}",
+		},
+		{
+			name:  "should return a timestamptz array",
+			value: *nullable.NewArray([]nullable.Time{nullable.TimeFrom(timeRef)}),
+			want:  `{"` + timeRef.Format(time.RFC3339Nano) + `"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
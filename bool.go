@@ -1,38 +1,97 @@
 package nullable
 
 import (
-	"bytes"
 	"database/sql"
-	"encoding/json"
+	"database/sql/driver"
+	"encoding/xml"
 )
 
 type Bool struct {
 	sql.NullBool
+	Policy NullPolicy
 }
 
 func NewBool(v bool) *Bool {
-	return &Bool{sql.NullBool{
+	return &Bool{NullBool: sql.NullBool{
 		Bool:  v,
 		Valid: true,
 	}}
 }
 
-func (n Bool) MarshalJSON() ([]byte, error) {
-	if !n.Valid {
-		return jsonNullBytes, nil
-	}
-	return json.Marshal(n.Bool)
+func BoolFrom(v bool) Bool {
+	return *NewBool(v)
 }
 
-func (n *Bool) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, jsonNullBytes) {
-		n.Valid = false
-		return nil
+func BoolFromPtr(v *bool) Bool {
+	if v == nil {
+		return Bool{}
 	}
-	err := json.Unmarshal(data, &n.Bool)
-	if err != nil {
-		return err
+	return BoolFrom(*v)
+}
+
+func (n Bool) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Bool) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Bool)
+}
+
+func (n Bool) Get() (bool, bool) {
+	return get(n.Bool, n.valid())
+}
+
+func (n Bool) ValueOr(v bool) bool {
+	return valueOr(n.Bool, n.valid(), v)
+}
+
+func (n Bool) MustGet() bool {
+	return mustGet(n.Bool, n.valid())
+}
+
+func (n Bool) Ptr() *bool {
+	return ptr(n.Bool, n.valid())
+}
+
+func (n *Bool) Scan(value interface{}) error {
+	return scanValue(value, &n.Bool, &n.Valid, n.policy(), convertAssign[bool])
+}
+
+func (n Bool) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
 	}
-	n.Valid = true
-	return nil
+	return n.NullBool.Value()
+}
+
+func (n Bool) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Bool, n.valid())
+}
+
+func (n *Bool) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Bool, &n.Valid, n.policy())
+}
+
+func (n Bool) MarshalText() ([]byte, error) {
+	return marshalText(n.Bool, n.valid())
+}
+
+func (n *Bool) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Bool, &n.Valid, n.policy())
+}
+
+func (n Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Bool, n.valid())
+}
+
+func (n *Bool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Bool, &n.Valid, n.policy())
+}
+
+func (n Bool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Bool, n.valid())
+}
+
+func (n *Bool) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Bool, &n.Valid, n.policy())
 }
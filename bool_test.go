@@ -244,3 +244,80 @@ func TestBool_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestBool_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   nullable.Bool
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "should return an empty text",
+			value:   nullable.Bool{},
+			want:    []byte(""),
+			wantErr: false,
+		},
+		{
+			name:    "should return the given value as text",
+			value:   *nullable.NewBool(true),
+			want:    []byte("true"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBool_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    nullable.Bool
+		wantErr bool
+	}{
+		{
+			name:    "should unmarshal an empty text as null",
+			text:    []byte(""),
+			want:    nullable.Bool{},
+			wantErr: false,
+		},
+		{
+			name:    "should unmarshal the given text",
+			text:    []byte("true"),
+			want:    *nullable.NewBool(true),
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			text:    []byte("maybe"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Bool
+			err := n.UnmarshalText(tt.text)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
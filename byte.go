@@ -0,0 +1,97 @@
+package nullable
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+type Byte struct {
+	sql.NullByte
+	Policy NullPolicy
+}
+
+func NewByte(v byte) *Byte {
+	return &Byte{NullByte: sql.NullByte{
+		Byte:  v,
+		Valid: true,
+	}}
+}
+
+func ByteFrom(v byte) Byte {
+	return *NewByte(v)
+}
+
+func ByteFromPtr(v *byte) Byte {
+	if v == nil {
+		return Byte{}
+	}
+	return ByteFrom(*v)
+}
+
+func (n Byte) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Byte) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Byte)
+}
+
+func (n Byte) Get() (byte, bool) {
+	return get(n.Byte, n.valid())
+}
+
+func (n Byte) ValueOr(v byte) byte {
+	return valueOr(n.Byte, n.valid(), v)
+}
+
+func (n Byte) MustGet() byte {
+	return mustGet(n.Byte, n.valid())
+}
+
+func (n Byte) Ptr() *byte {
+	return ptr(n.Byte, n.valid())
+}
+
+func (n *Byte) Scan(value interface{}) error {
+	return scanUint(value, &n.Byte, &n.Valid, n.policy(), 8)
+}
+
+func (n Byte) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return n.NullByte.Value()
+}
+
+func (n Byte) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Byte, n.valid())
+}
+
+func (n *Byte) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Byte, &n.Valid, n.policy())
+}
+
+func (n Byte) MarshalText() ([]byte, error) {
+	return marshalText(n.Byte, n.valid())
+}
+
+func (n *Byte) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Byte, &n.Valid, n.policy())
+}
+
+func (n Byte) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Byte, n.valid())
+}
+
+func (n *Byte) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Byte, &n.Valid, n.policy())
+}
+
+func (n Byte) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Byte, n.valid())
+}
+
+func (n *Byte) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Byte, &n.Valid, n.policy())
+}
@@ -0,0 +1,178 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// BytesFormat defines how Bytes values are encoded as JSON, text and XML.
+type BytesFormat uint8
+
+const (
+	// BytesBase64 encodes bytes as standard, padded base64, like encoding/json.
+	BytesBase64 BytesFormat = iota
+	// BytesHex encodes bytes as lowercase hexadecimal.
+	BytesHex
+	// BytesBase64URL encodes bytes as unpadded URL-safe base64. Padding is
+	// accepted when decoding.
+	BytesBase64URL
+)
+
+// BytesEncoding is the format used to encode and decode Bytes values.
+var BytesEncoding = BytesBase64
+
+// Bytes is a nullable byte slice, for BYTEA and BLOB columns. Unlike a plain
+// []byte, a valid empty value is kept apart from null, and scanned values are
+// copied so driver buffers are not retained.
+type Bytes struct {
+	Bytes  []byte
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewBytes(v []byte) *Bytes {
+	return &Bytes{
+		Bytes: v,
+		Valid: true,
+	}
+}
+
+func BytesFrom(v []byte) Bytes {
+	return *NewBytes(v)
+}
+
+// BytesFromPtr returns a null Bytes when v is nil, while BytesFrom(nil)
+// returns a valid, empty value.
+func BytesFromPtr(v *[]byte) Bytes {
+	if v == nil {
+		return Bytes{}
+	}
+	return BytesFrom(*v)
+}
+
+func (n Bytes) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Bytes) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Bytes)
+}
+
+func (n Bytes) Get() ([]byte, bool) {
+	return get(n.Bytes, n.valid())
+}
+
+func (n Bytes) ValueOr(v []byte) []byte {
+	return valueOr(n.Bytes, n.valid(), v)
+}
+
+func (n Bytes) MustGet() []byte {
+	return mustGet(n.Bytes, n.valid())
+}
+
+func (n Bytes) Ptr() *[]byte {
+	return ptr(n.Bytes, n.valid())
+}
+
+func (n *Bytes) Scan(value interface{}) error {
+	return scanValue(value, &n.Bytes, &n.Valid, n.policy(), toBytes)
+}
+
+// Value returns a non-nil slice for valid values, as drivers send nil slices
+// as NULL.
+func (n Bytes) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	if n.Bytes == nil {
+		return []byte{}, nil
+	}
+	return n.Bytes, nil
+}
+
+func (n Bytes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(bytesCodec{&n.Bytes}, n.valid())
+}
+
+func (n *Bytes) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &bytesCodec{&n.Bytes}, &n.Valid, n.policy())
+}
+
+func (n Bytes) MarshalText() ([]byte, error) {
+	return marshalText(bytesCodec{&n.Bytes}, n.valid())
+}
+
+func (n *Bytes) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &bytesCodec{&n.Bytes}, &n.Valid, n.policy())
+}
+
+func (n Bytes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, bytesCodec{&n.Bytes}, n.valid())
+}
+
+func (n *Bytes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &bytesCodec{&n.Bytes}, &n.Valid, n.policy())
+}
+
+func (n Bytes) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, bytesCodec{&n.Bytes}, n.valid())
+}
+
+func (n *Bytes) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &bytesCodec{&n.Bytes}, &n.Valid, n.policy())
+}
+
+type bytesCodec struct {
+	b *[]byte
+}
+
+func (c bytesCodec) IsZero() bool {
+	return len(*c.b) == 0
+}
+
+func (c bytesCodec) MarshalText() ([]byte, error) {
+	switch BytesEncoding {
+	case BytesHex:
+		return []byte(hex.EncodeToString(*c.b)), nil
+	case BytesBase64URL:
+		return []byte(base64.RawURLEncoding.EncodeToString(*c.b)), nil
+	}
+	return []byte(base64.StdEncoding.EncodeToString(*c.b)), nil
+}
+
+func (c *bytesCodec) UnmarshalText(text []byte) error {
+	var (
+		b   []byte
+		err error
+	)
+	switch BytesEncoding {
+	case BytesHex:
+		b, err = hex.DecodeString(string(text))
+	case BytesBase64URL:
+		b, err = base64.RawURLEncoding.DecodeString(strings.TrimRight(string(text), "="))
+	default:
+		b, err = base64.StdEncoding.DecodeString(string(text))
+	}
+	if err != nil {
+		return fmt.Errorf("nullable: cannot decode %q into bytes: %w", text, err)
+	}
+	if b == nil {
+		b = []byte{}
+	}
+	*c.b = b
+	return nil
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch src := value.(type) {
+	case []byte:
+		return append([]byte{}, src...), nil
+	case string:
+		return []byte(src), nil
+	}
+	return nil, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type Bytes", value)
+}
@@ -0,0 +1,267 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+)
+
+var bytesRef = []byte{0xfb, 0xff, 0x00, 0x01}
+
+func TestBytes_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding nullable.BytesFormat
+		value    interface{}
+		want     []byte
+	}{
+		{
+			name:  "should return null",
+			value: nullable.Bytes{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should return an empty string",
+			value: *nullable.NewBytes(nil),
+			want:  []byte(`""`),
+		},
+		{
+			name:  "should return base64",
+			value: *nullable.NewBytes(bytesRef),
+			want:  []byte(`"+/8AAQ=="`),
+		},
+		{
+			name:     "should return hex",
+			encoding: nullable.BytesHex,
+			value:    *nullable.NewBytes(bytesRef),
+			want:     []byte(`"fbff0001"`),
+		},
+		{
+			name:     "should return base64url",
+			encoding: nullable.BytesBase64URL,
+			value:    *nullable.NewBytes(bytesRef),
+			want:     []byte(`"-_8AAQ"`),
+		},
+		{
+			name: "should marshal the given bytes from a struct",
+			value: &struct {
+				ID    int            `json:"id"`
+				Value nullable.Bytes `json:"value"`
+			}{
+				ID:    100,
+				Value: *nullable.NewBytes([]byte("nullable")),
+			},
+			want: []byte(`{"id":100,"value":"bnVsbGFibGU="}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(encoding nullable.BytesFormat) { nullable.BytesEncoding = encoding }(nullable.BytesEncoding)
+			nullable.BytesEncoding = tt.encoding
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBytes_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding nullable.BytesFormat
+		policy   nullable.NullPolicy
+		data     []byte
+		want     nullable.Bytes
+		wantErr  bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			data: []byte("null"),
+			want: nullable.Bytes{},
+		},
+		{
+			name: "should unmarshal an empty value",
+			data: []byte(`""`),
+			want: *nullable.NewBytes([]byte{}),
+		},
+		{
+			name:   "should unmarshal an empty value as null",
+			policy: nullable.EmptyAsNull,
+			data:   []byte(`""`),
+			want:   nullable.Bytes{Policy: nullable.EmptyAsNull},
+		},
+		{
+			name: "should unmarshal base64",
+			data: []byte(`"+/8AAQ=="`),
+			want: *nullable.NewBytes(bytesRef),
+		},
+		{
+			name:     "should unmarshal hex",
+			encoding: nullable.BytesHex,
+			data:     []byte(`"FBFF0001"`),
+			want:     *nullable.NewBytes(bytesRef),
+		},
+		{
+			name:     "should unmarshal base64url",
+			encoding: nullable.BytesBase64URL,
+			data:     []byte(`"-_8AAQ"`),
+			want:     *nullable.NewBytes(bytesRef),
+		},
+		{
+			name:     "should unmarshal padded base64url",
+			encoding: nullable.BytesBase64URL,
+			data:     []byte(`"-_8AAQ=="`),
+			want:     *nullable.NewBytes(bytesRef),
+		},
+		{
+			name:    "should return an error due to invalid base64",
+			data:    []byte(`"-_8AAQ"`),
+			wantErr: true,
+		},
+		{
+			name:     "should return an error due to invalid hex",
+			encoding: nullable.BytesHex,
+			data:     []byte(`"fbf"`),
+			wantErr:  true,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			data:    []byte(`[1,2]`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(encoding nullable.BytesFormat) { nullable.BytesEncoding = encoding }(nullable.BytesEncoding)
+			nullable.BytesEncoding = tt.encoding
+			n := nullable.Bytes{Policy: tt.policy}
+			err := json.Unmarshal(tt.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestBytes_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    nullable.Bytes
+		wantErr bool
+	}{
+		{
+			name:  "should return null bytes",
+			value: nil,
+			want:  nullable.Bytes{},
+		},
+		{
+			name:  "should scan empty bytes",
+			value: []byte{},
+			want:  *nullable.NewBytes([]byte{}),
+		},
+		{
+			name:  "should scan bytes",
+			value: bytesRef,
+			want:  *nullable.NewBytes(bytesRef),
+		},
+		{
+			name:  "should scan a string",
+			value: "nullable",
+			want:  *nullable.NewBytes([]byte("nullable")),
+		},
+		{
+			name:    "should return an error due to an unsupported type",
+			value:   int64(1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Bytes
+			err := n.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestBytes_Scan_Copy(t *testing.T) {
+	src := []byte("nullable")
+	var n nullable.Bytes
+	if err := n.Scan(src); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	src[0] = 'N'
+	if string(n.Bytes) != "nullable" {
+		t.Errorf("Scan() retained the source buffer, got = %s", n.Bytes)
+	}
+}
+
+func TestBytes_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.Bytes
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.Bytes{},
+			want:  nil,
+		},
+		{
+			name:  "should return empty bytes",
+			value: *nullable.NewBytes(nil),
+			want:  []byte{},
+		},
+		{
+			name:  "should return bytes",
+			value: *nullable.NewBytes(bytesRef),
+			want:  bytesRef,
+		},
+		{
+			name:  "should return nil due to the empty as null policy",
+			value: nullable.Bytes{Bytes: []byte{}, Valid: true, Policy: nullable.EmptyAsNull},
+			want:  nil,
+		},
+		{
+			name:  "should return nil due to the zero as null policy",
+			value: nullable.Bytes{Bytes: []byte{}, Valid: true, Policy: nullable.ZeroAsNull},
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package nullable
+
+import (
+	"fmt"
+	"time"
+)
+
+// CivilDate is a date without time and time zone, as stored in DATE columns.
+type CivilDate struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// CivilDateOf returns the date of the given time, in its location.
+func CivilDateOf(t time.Time) CivilDate {
+	var d CivilDate
+	d.Year, d.Month, d.Day = t.Date()
+	return d
+}
+
+// ParseCivilDate parses dates in the "2006-01-02" layout.
+func ParseCivilDate(s string) (CivilDate, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return CivilDate{}, fmt.Errorf("nullable: invalid date %q: %w", s, err)
+	}
+	return CivilDateOf(t), nil
+}
+
+// IsZero reports whether d is the zero date, or the date of the zero
+// time.Time, January 1, year 1.
+func (d CivilDate) IsZero() bool {
+	return d == CivilDate{} || d == CivilDate{1, time.January, 1}
+}
+
+// In returns the time at midnight of the date in the given location.
+func (d CivilDate) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// Compare returns -1, 0 or +1 whether d is before, equal to or after other.
+func (d CivilDate) Compare(other CivilDate) int {
+	return d.In(time.UTC).Compare(other.In(time.UTC))
+}
+
+func (d CivilDate) Before(other CivilDate) bool {
+	return d.Compare(other) < 0
+}
+
+func (d CivilDate) After(other CivilDate) bool {
+	return d.Compare(other) > 0
+}
+
+func (d CivilDate) next() CivilDate {
+	return CivilDateOf(d.In(time.UTC).AddDate(0, 0, 1))
+}
+
+func (d CivilDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+func (d CivilDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *CivilDate) UnmarshalText(text []byte) error {
+	parsed, err := ParseCivilDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
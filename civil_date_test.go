@@ -0,0 +1,30 @@
+package nullable_test
+
+import (
+	"github.com/diegohordi/nullable"
+	"testing"
+	"time"
+)
+
+func TestCivilDate(t *testing.T) {
+	d := nullable.CivilDateOf(time.Date(2021, 11, 23, 12, 10, 0, 0, time.UTC))
+	if d != dateRef {
+		t.Errorf("CivilDateOf() got = %v, want %v", d, dateRef)
+	}
+	if got := d.In(time.UTC); !got.Equal(time.Date(2021, 11, 23, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("In() got = %v", got)
+	}
+	next := nullable.CivilDate{Year: 2021, Month: time.November, Day: 24}
+	if !d.Before(next) || d.After(next) || !next.After(d) {
+		t.Errorf("Before() and After() got unexpected results for %v and %v", d, next)
+	}
+	if (nullable.CivilDate{Year: 5, Month: time.January, Day: 2}).String() != "0005-01-02" {
+		t.Errorf("String() got unexpected padding")
+	}
+	if !(nullable.CivilDate{}).IsZero() || !nullable.CivilDateOf(time.Time{}).IsZero() || d.IsZero() {
+		t.Errorf("IsZero() got unexpected results")
+	}
+	if _, err := nullable.ParseCivilDate("23/11/2021"); err == nil {
+		t.Errorf("ParseCivilDate() expected an error")
+	}
+}
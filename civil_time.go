@@ -0,0 +1,80 @@
+package nullable
+
+import (
+	"fmt"
+	"time"
+)
+
+const civilTimeLayout = "15:04:05.999999"
+
+// CivilTime is a time of day without date and time zone, as stored in TIME
+// columns.
+type CivilTime struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// CivilTimeOf returns the time of day of the given time, in its location.
+func CivilTimeOf(t time.Time) CivilTime {
+	var c CivilTime
+	c.Hour, c.Minute, c.Second = t.Clock()
+	c.Nanosecond = t.Nanosecond()
+	return c
+}
+
+// ParseCivilTime parses times of day in the "15:04:05" layout, with optional
+// fractional seconds, as well as in the "15:04" layout.
+func ParseCivilTime(s string) (CivilTime, error) {
+	t, err := time.Parse(time.TimeOnly, s)
+	if err != nil {
+		if t, err = time.Parse("15:04", s); err != nil {
+			return CivilTime{}, fmt.Errorf("nullable: invalid time of day %q", s)
+		}
+	}
+	return CivilTimeOf(t), nil
+}
+
+func (c CivilTime) IsZero() bool {
+	return c == CivilTime{}
+}
+
+// On returns the time of day on the given date and location.
+func (c CivilTime) On(d CivilDate, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, c.Hour, c.Minute, c.Second, c.Nanosecond, loc)
+}
+
+// Compare returns -1, 0 or +1 whether c is before, equal to or after other.
+func (c CivilTime) Compare(other CivilTime) int {
+	return c.time().Compare(other.time())
+}
+
+func (c CivilTime) Before(other CivilTime) bool {
+	return c.Compare(other) < 0
+}
+
+func (c CivilTime) After(other CivilTime) bool {
+	return c.Compare(other) > 0
+}
+
+func (c CivilTime) String() string {
+	return c.time().Format(civilTimeLayout)
+}
+
+func (c CivilTime) time() time.Time {
+	return c.On(CivilDate{Year: 1, Month: time.January, Day: 1}, time.UTC)
+}
+
+func (c CivilTime) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *CivilTime) UnmarshalText(text []byte) error {
+	parsed, err := ParseCivilTime(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
@@ -0,0 +1,125 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+type Date struct {
+	Date   CivilDate
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewDate(v CivilDate) *Date {
+	return &Date{
+		Date:  v,
+		Valid: true,
+	}
+}
+
+func DateFrom(v CivilDate) Date {
+	return *NewDate(v)
+}
+
+func DateFromPtr(v *CivilDate) Date {
+	if v == nil {
+		return Date{}
+	}
+	return DateFrom(*v)
+}
+
+func (n Date) policy() NullPolicy {
+	return n.Policy.resolve(ZeroAsNull)
+}
+
+func (n Date) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Date)
+}
+
+func (n Date) Get() (CivilDate, bool) {
+	return get(n.Date, n.valid())
+}
+
+func (n Date) ValueOr(v CivilDate) CivilDate {
+	return valueOr(n.Date, n.valid(), v)
+}
+
+func (n Date) MustGet() CivilDate {
+	return mustGet(n.Date, n.valid())
+}
+
+func (n Date) Ptr() *CivilDate {
+	return ptr(n.Date, n.valid())
+}
+
+// Scan accepts time.Time values, dates and timestamps. Like Time, MySQL zero
+// dates are scanned as null.
+func (n *Date) Scan(value interface{}) error {
+	if isZeroDateTime(value) {
+		value = nil
+	}
+	return scanValue(value, &n.Date, &n.Valid, n.policy(), toCivilDate)
+}
+
+func (n Date) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return n.Date.String(), nil
+}
+
+func (n Date) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Date, n.valid())
+}
+
+func (n *Date) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Date, &n.Valid, n.policy())
+}
+
+func (n Date) MarshalText() ([]byte, error) {
+	return marshalText(n.Date, n.valid())
+}
+
+func (n *Date) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Date, &n.Valid, n.policy())
+}
+
+func (n Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Date, n.valid())
+}
+
+func (n *Date) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Date, &n.Valid, n.policy())
+}
+
+func (n Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Date, n.valid())
+}
+
+func (n *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Date, &n.Valid, n.policy())
+}
+
+func toCivilDate(value interface{}) (CivilDate, error) {
+	switch src := value.(type) {
+	case time.Time:
+		return CivilDateOf(src), nil
+	case string:
+		return parseDateValue(src)
+	case []byte:
+		return parseDateValue(string(src))
+	}
+	return CivilDate{}, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type Date", value)
+}
+
+// parseDateValue also accepts timestamps, as returned by some drivers for
+// DATE columns, discarding their time.
+func parseDateValue(s string) (CivilDate, error) {
+	if len(s) > len(time.DateOnly) && (s[len(time.DateOnly)] == ' ' || s[len(time.DateOnly)] == 'T') {
+		s = s[:len(time.DateOnly)]
+	}
+	return ParseCivilDate(s)
+}
@@ -0,0 +1,357 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var dateRef = nullable.CivilDate{Year: 2021, Month: time.November, Day: 23}
+
+func TestDate_MarshalJSON(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should return null",
+			fields: fields{
+				value: nullable.Date{},
+			},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name: "should return null for a zero date",
+			fields: fields{
+				value: *nullable.NewDate(nullable.CivilDate{}),
+			},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name: "should return the given date",
+			fields: fields{
+				value: *nullable.NewDate(dateRef),
+			},
+			want:    []byte(`"2021-11-23"`),
+			wantErr: false,
+		},
+		{
+			name: "should marshal the given date from a struct",
+			fields: fields{
+				value: &struct {
+					ID    int           `json:"id"`
+					Value nullable.Date `json:"value"`
+				}{
+					ID:    100,
+					Value: *nullable.NewDate(dateRef),
+				},
+			},
+			want:    []byte(`{"id":100,"value":"2021-11-23"}`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    nullable.Date
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			args: args{
+				data: []byte("null"),
+			},
+			want:    nullable.Date{},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal an empty string",
+			args: args{
+				data: []byte(`""`),
+			},
+			want:    nullable.Date{},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal the given date",
+			args: args{
+				data: []byte(`"2021-11-23"`),
+			},
+			want:    *nullable.NewDate(dateRef),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to a timestamp",
+			args: args{
+				data: []byte(`"2021-11-23T12:10:00Z"`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an unexpected value",
+			args: args{
+				data: []byte(`false`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Date
+			err := json.Unmarshal(tt.args.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_Scan(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    nullable.Date
+		wantErr bool
+	}{
+		{
+			name: "should return a null date",
+			fields: fields{
+				value: nil,
+			},
+			want:    nullable.Date{},
+			wantErr: false,
+		},
+		{
+			name: "should keep the date of a time in another time zone",
+			fields: fields{
+				value: time.Date(2021, 11, 23, 23, 30, 0, 0, time.FixedZone("UTC-3", -3*60*60)),
+			},
+			want:    *nullable.NewDate(dateRef),
+			wantErr: false,
+		},
+		{
+			name: "should scan a string",
+			fields: fields{
+				value: "2021-11-23",
+			},
+			want:    *nullable.NewDate(dateRef),
+			wantErr: false,
+		},
+		{
+			name: "should scan a timestamp as bytes",
+			fields: fields{
+				value: []byte("2021-11-23 00:00:00"),
+			},
+			want:    *nullable.NewDate(dateRef),
+			wantErr: false,
+		},
+		{
+			name: "should return a null date for a zero time",
+			fields: fields{
+				value: time.Time{},
+			},
+			want:    nullable.Date{Date: nullable.CivilDateOf(time.Time{})},
+			wantErr: false,
+		},
+		{
+			name: "should return a null date for a MySQL zero date",
+			fields: fields{
+				value: "0000-00-00",
+			},
+			want:    nullable.Date{},
+			wantErr: false,
+		},
+		{
+			name: "should return a null date for a MySQL zero timestamp as bytes",
+			fields: fields{
+				value: []byte("0000-00-00 00:00:00"),
+			},
+			want:    nullable.Date{},
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an invalid date",
+			fields: fields{
+				value: "2021-02-30",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an unsupported format",
+			fields: fields{
+				value: int64(100),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Date
+			err := n.Scan(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.Date
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.Date{},
+			want:  nil,
+		},
+		{
+			name:  "should return the date only",
+			value: *nullable.NewDate(dateRef),
+			want:  "2021-11-23",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   nullable.Date
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "should return an empty text",
+			value:   nullable.Date{},
+			want:    []byte(""),
+			wantErr: false,
+		},
+		{
+			name:    "should return the given value as text",
+			value:   *nullable.NewDate(dateRef),
+			want:    []byte("2021-11-23"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    nullable.Date
+		wantErr bool
+	}{
+		{
+			name:    "should unmarshal an empty text as null",
+			text:    []byte(""),
+			want:    nullable.Date{},
+			wantErr: false,
+		},
+		{
+			name:    "should unmarshal the given text",
+			text:    []byte("2021-11-23"),
+			want:    *nullable.NewDate(dateRef),
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to a timestamp",
+			text:    []byte(timeRefStr),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an invalid date",
+			text:    []byte("2021-02-30"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Date
+			err := n.UnmarshalText(tt.text)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+var (
+	// DecimalJSONString tells whether Decimal values are marshaled as JSON
+	// strings instead of numbers.
+	DecimalJSONString = false
+	// DecimalScale, when not negative, is the number of fractional digits
+	// Decimal values are rounded to when encoded or sent to the database,
+	// using DecimalRounding.
+	DecimalScale = -1
+	// DecimalRounding is the rounding mode used along with DecimalScale.
+	DecimalRounding = big.ToNearestEven
+)
+
+// decimalMaxScale limits the fractional digits of values without a finite
+// decimal representation, such as 1/3, when DecimalScale is negative.
+const decimalMaxScale = 32
+
+// Decimal is an arbitrary-precision decimal number, suited for NUMERIC and
+// DECIMAL columns. The underlying big.Rat should not be changed once assigned.
+type Decimal struct {
+	Decimal *big.Rat
+	Valid   bool
+	Policy  NullPolicy
+}
+
+func NewDecimal(v *big.Rat) *Decimal {
+	return &Decimal{
+		Decimal: v,
+		Valid:   v != nil,
+	}
+}
+
+func DecimalFrom(v *big.Rat) Decimal {
+	return *NewDecimal(v)
+}
+
+func DecimalFromPtr(v **big.Rat) Decimal {
+	if v == nil {
+		return Decimal{}
+	}
+	return DecimalFrom(*v)
+}
+
+// ParseDecimal parses a decimal number, optionally followed by an exponent.
+func ParseDecimal(s string) (Decimal, error) {
+	v, err := parseDecimal(s)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return DecimalFrom(v), nil
+}
+
+func (n Decimal) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Decimal) valid() bool {
+	return n.Valid && n.Decimal != nil && !n.policy().nulls(n.Decimal)
+}
+
+func (n Decimal) Get() (*big.Rat, bool) {
+	return get(n.Decimal, n.valid())
+}
+
+func (n Decimal) ValueOr(v *big.Rat) *big.Rat {
+	return valueOr(n.Decimal, n.valid(), v)
+}
+
+func (n Decimal) MustGet() *big.Rat {
+	return mustGet(n.Decimal, n.valid())
+}
+
+func (n Decimal) Ptr() **big.Rat {
+	return ptr(n.Decimal, n.valid())
+}
+
+// Round returns the value rounded to the given number of fractional digits,
+// using the given rounding mode.
+func (n Decimal) Round(scale int, mode big.RoundingMode) Decimal {
+	if !n.valid() {
+		return n
+	}
+	n.Decimal = roundDecimal(n.Decimal, scale, mode)
+	return n
+}
+
+// String returns the decimal representation of the value, or an empty string
+// when null.
+func (n Decimal) String() string {
+	if !n.valid() {
+		return ""
+	}
+	return formatDecimal(n.Decimal)
+}
+
+func (n *Decimal) Scan(value interface{}) error {
+	return scanValue(value, &n.Decimal, &n.Valid, n.policy(), toDecimal)
+}
+
+func (n Decimal) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return formatDecimal(n.Decimal), nil
+}
+
+func (n Decimal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(decimalCodec{&n.Decimal}, n.valid())
+}
+
+func (n *Decimal) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &decimalCodec{&n.Decimal}, &n.Valid, n.policy())
+}
+
+func (n Decimal) MarshalText() ([]byte, error) {
+	return marshalText(decimalCodec{&n.Decimal}, n.valid())
+}
+
+func (n *Decimal) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &decimalCodec{&n.Decimal}, &n.Valid, n.policy())
+}
+
+func (n Decimal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, decimalCodec{&n.Decimal}, n.valid())
+}
+
+func (n *Decimal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &decimalCodec{&n.Decimal}, &n.Valid, n.policy())
+}
+
+func (n Decimal) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, decimalCodec{&n.Decimal}, n.valid())
+}
+
+func (n *Decimal) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &decimalCodec{&n.Decimal}, &n.Valid, n.policy())
+}
+
+type decimalCodec struct {
+	r **big.Rat
+}
+
+func (c decimalCodec) IsZero() bool {
+	return *c.r == nil || (*c.r).Sign() == 0
+}
+
+func (c decimalCodec) MarshalText() ([]byte, error) {
+	return []byte(formatDecimal(*c.r)), nil
+}
+
+func (c *decimalCodec) UnmarshalText(text []byte) error {
+	r, err := parseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*c.r = r
+	return nil
+}
+
+func (c decimalCodec) MarshalJSON() ([]byte, error) {
+	s := formatDecimal(*c.r)
+	if DecimalJSONString {
+		return json.Marshal(s)
+	}
+	return []byte(s), nil
+}
+
+func (c *decimalCodec) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+func toDecimal(value interface{}) (*big.Rat, error) {
+	switch src := value.(type) {
+	case int64:
+		return new(big.Rat).SetInt64(src), nil
+	case float64:
+		if math.IsNaN(src) || math.IsInf(src, 0) {
+			return nil, fmt.Errorf("nullable: cannot scan %v into Decimal", src)
+		}
+		return parseDecimal(strconv.FormatFloat(src, 'g', -1, 64))
+	case string:
+		return parseDecimal(src)
+	case []byte:
+		return parseDecimal(string(src))
+	}
+	return nil, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type Decimal", value)
+}
+
+func parseDecimal(s string) (*big.Rat, error) {
+	if !numberRegexp.MatchString(s) {
+		return nil, fmt.Errorf("nullable: invalid decimal %q", s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("nullable: invalid decimal %q", s)
+	}
+	return r, nil
+}
+
+func formatDecimal(r *big.Rat) string {
+	if DecimalScale >= 0 {
+		return roundDecimal(r, DecimalScale, DecimalRounding).FloatString(DecimalScale)
+	}
+	scale, exact := r.FloatPrec()
+	if !exact {
+		return roundDecimal(r, decimalMaxScale, DecimalRounding).FloatString(decimalMaxScale)
+	}
+	return r.FloatString(scale)
+}
+
+func roundDecimal(r *big.Rat, scale int, mode big.RoundingMode) *big.Rat {
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	num := new(big.Int).Mul(r.Num(), pow)
+	den := r.Denom()
+	q, rem := new(big.Int).DivMod(num, den, new(big.Int))
+	if rem.Sign() != 0 {
+		up := false
+		switch mode {
+		case big.ToPositiveInf:
+			up = true
+		case big.ToZero:
+			up = num.Sign() < 0
+		case big.AwayFromZero:
+			up = num.Sign() > 0
+		case big.ToNearestEven, big.ToNearestAway:
+			switch new(big.Int).Lsh(rem, 1).Cmp(den) {
+			case 1:
+				up = true
+			case 0:
+				if mode == big.ToNearestAway {
+					up = num.Sign() > 0
+				} else {
+					up = q.Bit(0) == 1
+				}
+			}
+		}
+		if up {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return new(big.Rat).SetFrac(q, pow)
+}
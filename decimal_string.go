@@ -0,0 +1,34 @@
+package nullable
+
+import "math/big"
+
+// DecimalString is a Decimal encoded as a JSON string, regardless of
+// DecimalJSONString, so that no precision is lost by JavaScript clients. Both
+// strings and numbers are accepted when decoding. Scan, Value and the text and
+// XML encodings are the same of Decimal.
+type DecimalString struct {
+	Decimal
+}
+
+func NewDecimalString(v *big.Rat) *DecimalString {
+	return &DecimalString{*NewDecimal(v)}
+}
+
+func DecimalStringFrom(v *big.Rat) DecimalString {
+	return *NewDecimalString(v)
+}
+
+func DecimalStringFromPtr(v **big.Rat) DecimalString {
+	if v == nil {
+		return DecimalString{}
+	}
+	return DecimalStringFrom(*v)
+}
+
+func (n DecimalString) MarshalJSON() ([]byte, error) {
+	return marshalJSON(jsonString[decimalCodec]{&decimalCodec{&n.Decimal.Decimal}}, n.valid())
+}
+
+func (n *DecimalString) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &jsonString[decimalCodec]{&decimalCodec{&n.Decimal.Decimal}}, &n.Valid, n.policy())
+}
@@ -0,0 +1,264 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func mustDecimal(s string) nullable.Decimal {
+	d, err := nullable.ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestDecimal_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		asString bool
+		scale    int
+		value    interface{}
+		want     []byte
+		wantErr  bool
+	}{
+		{
+			name:  "should return null",
+			scale: -1,
+			value: nullable.Decimal{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should return the given decimal as a number",
+			scale: -1,
+			value: mustDecimal("12345678901234567890.0123456789"),
+			want:  []byte("12345678901234567890.0123456789"),
+		},
+		{
+			name:     "should return the given decimal as a string",
+			asString: true,
+			scale:    -1,
+			value:    mustDecimal("-0.10"),
+			want:     []byte(`"-0.1"`),
+		},
+		{
+			name:  "should return the given decimal with the configured scale",
+			scale: 2,
+			value: mustDecimal("1.005"),
+			want:  []byte("1.00"),
+		},
+		{
+			name:  "should return a non terminating decimal rounded",
+			scale: -1,
+			value: nullable.DecimalFrom(big.NewRat(1, 3)),
+			want:  []byte("0.33333333333333333333333333333333"),
+		},
+		{
+			name:  "should marshal the given decimal from a struct",
+			scale: -1,
+			value: &struct {
+				ID    int              `json:"id"`
+				Value nullable.Decimal `json:"value"`
+			}{
+				ID:    100,
+				Value: mustDecimal("1.5"),
+			},
+			want: []byte(`{"id":100,"value":1.5}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(asString bool, scale int) {
+				nullable.DecimalJSONString, nullable.DecimalScale = asString, scale
+			}(nullable.DecimalJSONString, nullable.DecimalScale)
+			nullable.DecimalJSONString, nullable.DecimalScale = tt.asString, tt.scale
+			got, err := json.Marshal(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			data: []byte("null"),
+			want: "",
+		},
+		{
+			name: "should unmarshal a number without losing precision",
+			data: []byte("0.1000000000000000000000001"),
+			want: "0.1000000000000000000000001",
+		},
+		{
+			name: "should unmarshal a string",
+			data: []byte(`"-1.5e3"`),
+			want: "-1500",
+		},
+		{
+			name:    "should return an error due to a fraction",
+			data:    []byte(`"1/3"`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			data:    []byte("true"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Decimal
+			err := json.Unmarshal(tt.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if n.String() != tt.want || n.Valid != (tt.want != "") {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "should return a null decimal",
+			value: nil,
+			want:  "",
+		},
+		{
+			name:  "should scan a string",
+			value: "123.4500",
+			want:  "123.45",
+		},
+		{
+			name:  "should scan bytes",
+			value: []byte("-0.01"),
+			want:  "-0.01",
+		},
+		{
+			name:  "should scan an int64",
+			value: int64(math.MaxInt64),
+			want:  "9223372036854775807",
+		},
+		{
+			name:  "should scan a float64",
+			value: 0.1,
+			want:  "0.1",
+		},
+		{
+			name:    "should return an error due to NaN",
+			value:   math.NaN(),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unsupported format",
+			value:   true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Decimal
+			err := n.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if n.String() != tt.want || n.Valid != (tt.want != "") {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.Decimal
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.Decimal{},
+			want:  nil,
+		},
+		{
+			name:  "should return the exact decimal string",
+			value: mustDecimal("99999999999999999999.99"),
+			want:  "99999999999999999999.99",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Round(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		scale int
+		mode  big.RoundingMode
+		want  string
+	}{
+		{name: "should round half to even down", value: "2.5", mode: big.ToNearestEven, want: "2"},
+		{name: "should round half to even up", value: "3.5", mode: big.ToNearestEven, want: "4"},
+		{name: "should round half away from zero", value: "-2.5", mode: big.ToNearestAway, want: "-3"},
+		{name: "should round towards zero", value: "-2.9", mode: big.ToZero, want: "-2"},
+		{name: "should round away from zero", value: "2.1", mode: big.AwayFromZero, want: "3"},
+		{name: "should round towards negative infinity", value: "-2.1", mode: big.ToNegativeInf, want: "-3"},
+		{name: "should round towards positive infinity", value: "-2.9", mode: big.ToPositiveInf, want: "-2"},
+		{name: "should round to the given scale", value: "1.23456", scale: 3, mode: big.ToNearestEven, want: "1.235"},
+		{name: "should keep exact values", value: "1.2", scale: 3, mode: big.ToZero, want: "1.2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mustDecimal(tt.value).Round(tt.scale, tt.mode).String()
+			if got != tt.want {
+				t.Errorf("Round() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	if got := (nullable.Decimal{}).Round(2, big.ToZero); got.Valid {
+		t.Errorf("Round() got = %v, want null", got)
+	}
+}
@@ -0,0 +1,163 @@
+package nullable
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DecodeMode defines how JSON numbers and booleans are decoded.
+type DecodeMode uint8
+
+const (
+	// StandardDecoding follows the encoding/json rules.
+	StandardDecoding DecodeMode = iota
+	// LenientDecoding also accepts quoted numbers and booleans, numeric
+	// booleans (1 and 0), textual booleans such as "yes" and "off", and
+	// integers written with fractions or exponents, such as 1e3, as long as
+	// they hold an exact integer.
+	LenientDecoding
+	// StrictDecoding accepts the same values as LenientDecoding, but integers
+	// must be written without fractions or exponents, so 1e3 and 1.0 are
+	// rejected for integer types.
+	StrictDecoding
+)
+
+// JSONDecodeMode is the mode used when unmarshaling JSON numbers and booleans.
+var JSONDecodeMode = StandardDecoding
+
+// DecodeError is returned when a JSON value cannot be decoded under the
+// current DecodeMode.
+type DecodeError struct {
+	Input string
+	Type  string
+	Err   error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("nullable: cannot decode %s into %s: %v", e.Input, e.Type, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	errNotBool    = errors.New("not a boolean")
+	errNotNumber  = errors.New("not a number")
+	errNotInteger = errors.New("not an integer")
+	integerRegexp = regexp.MustCompile(`^-?(0|[1-9][0-9]*)$`)
+	numberRegexp  = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+)
+
+var textualBools = map[string]bool{
+	"true": true, "t": true, "yes": true, "y": true, "on": true, "1": true,
+	"false": false, "f": false, "no": false, "n": false, "off": false, "0": false,
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+func decodeJSON(data []byte, v interface{}) error {
+	if JSONDecodeMode != StandardDecoding {
+		if handled, err := decodeScalar(data, v, JSONDecodeMode); handled {
+			return err
+		}
+	}
+	return json.Unmarshal(data, v)
+}
+
+func decodeScalar(data []byte, v interface{}, mode DecodeMode) (bool, error) {
+	rv := reflect.ValueOf(v).Elem()
+	switch rv.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+	default:
+		return false, nil
+	}
+	// Types decoding themselves, such as enums, keep their own rules.
+	if pt := reflect.PointerTo(rv.Type()); pt.Implements(jsonUnmarshalerType) || pt.Implements(textUnmarshalerType) {
+		return false, nil
+	}
+	if err := decodeScalarValue(data, rv, mode); err != nil {
+		return true, &DecodeError{Input: string(data), Type: rv.Type().String(), Err: err}
+	}
+	return true, nil
+}
+
+func decodeScalarValue(data []byte, rv reflect.Value, mode DecodeMode) error {
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		s = strings.TrimSpace(s)
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		b, ok := textualBools[strings.ToLower(s)]
+		if !ok {
+			return errNotBool
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f, err := parseInteger(s, mode)
+		if err != nil {
+			return err
+		}
+		i, acc := f.Int64()
+		if acc != big.Exact || rv.OverflowInt(i) {
+			return ErrOutOfRange
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		f, err := parseInteger(s, mode)
+		if err != nil {
+			return err
+		}
+		u, acc := f.Uint64()
+		if acc != big.Exact || rv.OverflowUint(u) {
+			return ErrOutOfRange
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		if !numberRegexp.MatchString(s) {
+			return errNotNumber
+		}
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if errors.Is(err, strconv.ErrRange) {
+			return ErrOutOfRange
+		}
+		if err != nil {
+			return errNotNumber
+		}
+		rv.SetFloat(f)
+	}
+	return nil
+}
+
+func parseInteger(s string, mode DecodeMode) (*big.Float, error) {
+	if !numberRegexp.MatchString(s) {
+		return nil, errNotNumber
+	}
+	if mode == StrictDecoding && !integerRegexp.MatchString(s) {
+		return nil, errNotInteger
+	}
+	f, _, err := big.ParseFloat(s, 10, 256, big.ToNearestEven)
+	if err != nil {
+		return nil, errNotNumber
+	}
+	if !f.IsInt() {
+		return nil, errNotInteger
+	}
+	return f, nil
+}
@@ -0,0 +1,189 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeMode_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    nullable.DecodeMode
+		data    []byte
+		holder  interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "should reject a quoted number by default",
+			mode:    nullable.StandardDecoding,
+			data:    []byte(`"42"`),
+			holder:  &nullable.Int64{},
+			wantErr: true,
+		},
+		{
+			name:   "should accept a quoted number",
+			mode:   nullable.LenientDecoding,
+			data:   []byte(`"42"`),
+			holder: &nullable.Int64{},
+			want:   nullable.NewInt64(42),
+		},
+		{
+			name:   "should accept an integer with exponent",
+			mode:   nullable.LenientDecoding,
+			data:   []byte(`1e3`),
+			holder: &nullable.Int64{},
+			want:   nullable.NewInt64(1000),
+		},
+		{
+			name:    "should reject an integer with fraction",
+			mode:    nullable.LenientDecoding,
+			data:    []byte(`"1.5"`),
+			holder:  &nullable.Int64{},
+			wantErr: true,
+		},
+		{
+			name:    "should reject an overflowed integer",
+			mode:    nullable.LenientDecoding,
+			data:    []byte(`"256"`),
+			holder:  &nullable.Uint8{},
+			wantErr: true,
+		},
+		{
+			name:   "should accept a quoted float",
+			mode:   nullable.LenientDecoding,
+			data:   []byte(`"1.5"`),
+			holder: &nullable.Float64{},
+			want:   nullable.NewFloat64(1.5),
+		},
+		{
+			name:    "should reject a textual float",
+			mode:    nullable.LenientDecoding,
+			data:    []byte(`"Inf"`),
+			holder:  &nullable.Float64{},
+			wantErr: true,
+		},
+		{
+			name:   "should accept a numeric boolean",
+			mode:   nullable.LenientDecoding,
+			data:   []byte(`1`),
+			holder: &nullable.Bool{},
+			want:   nullable.NewBool(true),
+		},
+		{
+			name:   "should accept a textual boolean",
+			mode:   nullable.LenientDecoding,
+			data:   []byte(`"Off"`),
+			holder: &nullable.Bool{},
+			want:   nullable.NewBool(false),
+		},
+		{
+			name:    "should reject an unknown textual boolean",
+			mode:    nullable.LenientDecoding,
+			data:    []byte(`"maybe"`),
+			holder:  &nullable.Bool{},
+			wantErr: true,
+		},
+		{
+			name:   "should keep decoding null",
+			mode:   nullable.LenientDecoding,
+			data:   []byte(`null`),
+			holder: &nullable.Int64{},
+			want:   &nullable.Int64{},
+		},
+		{
+			name:   "should accept an integer",
+			mode:   nullable.StrictDecoding,
+			data:   []byte(`-42`),
+			holder: &nullable.Int32{},
+			want:   nullable.NewInt32(-42),
+		},
+		{
+			name:   "should accept a quoted integer",
+			mode:   nullable.StrictDecoding,
+			data:   []byte(`"42"`),
+			holder: &nullable.Int64{},
+			want:   nullable.NewInt64(42),
+		},
+		{
+			name:    "should reject an integer with exponent",
+			mode:    nullable.StrictDecoding,
+			data:    []byte(`1e3`),
+			holder:  &nullable.Int64{},
+			wantErr: true,
+		},
+		{
+			name:    "should reject a quoted integer with exponent",
+			mode:    nullable.StrictDecoding,
+			data:    []byte(`"1e3"`),
+			holder:  &nullable.Int64{},
+			wantErr: true,
+		},
+		{
+			name:    "should reject an integer with fraction",
+			mode:    nullable.StrictDecoding,
+			data:    []byte(`"1.0"`),
+			holder:  &nullable.Uint16{},
+			wantErr: true,
+		},
+		{
+			name:   "should accept a numeric boolean",
+			mode:   nullable.StrictDecoding,
+			data:   []byte(`1`),
+			holder: &nullable.Bool{},
+			want:   nullable.NewBool(true),
+		},
+		{
+			name:   "should accept a textual boolean",
+			mode:   nullable.StrictDecoding,
+			data:   []byte(`"Off"`),
+			holder: &nullable.Bool{},
+			want:   nullable.NewBool(false),
+		},
+		{
+			name:   "should accept a quoted float with exponent",
+			mode:   nullable.StrictDecoding,
+			data:   []byte(`"1e3"`),
+			holder: &nullable.Float32{},
+			want:   nullable.NewFloat32(1000),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(mode nullable.DecodeMode) { nullable.JSONDecodeMode = mode }(nullable.JSONDecodeMode)
+			nullable.JSONDecodeMode = tt.mode
+			err := json.Unmarshal(tt.data, tt.holder)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(tt.holder, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", tt.holder, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	defer func(mode nullable.DecodeMode) { nullable.JSONDecodeMode = mode }(nullable.JSONDecodeMode)
+	nullable.JSONDecodeMode = nullable.LenientDecoding
+	var n nullable.Int8
+	err := json.Unmarshal([]byte(`"300"`), &n)
+	var decodeErr *nullable.DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("UnmarshalJSON() error = %v, want a DecodeError", err)
+	}
+	if decodeErr.Input != `"300"` || decodeErr.Type != "int8" || !errors.Is(err, nullable.ErrOutOfRange) {
+		t.Errorf("UnmarshalJSON() error = %#v", decodeErr)
+	}
+	want := `nullable: cannot decode "300" into int8: nullable: value out of range`
+	if err.Error() != want {
+		t.Errorf("Error() got = %s, want %s", err.Error(), want)
+	}
+}
@@ -0,0 +1,319 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationFormat defines how Duration values are encoded as JSON, text and
+// XML.
+type DurationFormat uint8
+
+const (
+	// DurationGo encodes durations as Go duration strings, such as "1h30m0s".
+	DurationGo DurationFormat = iota
+	// DurationISO8601 encodes durations as ISO 8601 durations, such as
+	// "PT1H30M".
+	DurationISO8601
+)
+
+var (
+	// DurationEncoding is the format used to encode Duration values.
+	DurationEncoding = DurationGo
+	// DurationNumberUnit is the unit of numbers decoded into Duration values,
+	// including BIGINT columns, and of the values sent to the database, which
+	// are rounded to it.
+	DurationNumberUnit = time.Nanosecond
+	// DurationIntervalValue tells whether Duration values are sent to the
+	// database as ISO 8601 strings, as accepted by Postgres interval columns,
+	// instead of numbers of DurationNumberUnit.
+	DurationIntervalValue = false
+)
+
+// Years and months are converted using the same approximations of Postgres.
+const (
+	day   = 24 * time.Hour
+	month = 30 * day
+	year  = 365*day + 6*time.Hour
+)
+
+type Duration struct {
+	Duration time.Duration
+	Valid    bool
+	Policy   NullPolicy
+}
+
+func NewDuration(v time.Duration) *Duration {
+	return &Duration{
+		Duration: v,
+		Valid:    true,
+	}
+}
+
+func DurationFrom(v time.Duration) Duration {
+	return *NewDuration(v)
+}
+
+func DurationFromPtr(v *time.Duration) Duration {
+	if v == nil {
+		return Duration{}
+	}
+	return DurationFrom(*v)
+}
+
+func (n Duration) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Duration) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Duration)
+}
+
+func (n Duration) Get() (time.Duration, bool) {
+	return get(n.Duration, n.valid())
+}
+
+func (n Duration) ValueOr(v time.Duration) time.Duration {
+	return valueOr(n.Duration, n.valid(), v)
+}
+
+func (n Duration) MustGet() time.Duration {
+	return mustGet(n.Duration, n.valid())
+}
+
+func (n Duration) Ptr() *time.Duration {
+	return ptr(n.Duration, n.valid())
+}
+
+func (n *Duration) Scan(value interface{}) error {
+	return scanValue(value, &n.Duration, &n.Valid, n.policy(), toDuration)
+}
+
+func (n Duration) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	if DurationIntervalValue {
+		return formatISO8601Duration(n.Duration), nil
+	}
+	return int64(n.Duration.Round(DurationNumberUnit) / DurationNumberUnit), nil
+}
+
+func (n Duration) MarshalJSON() ([]byte, error) {
+	return marshalJSON(durationCodec{&n.Duration}, n.valid())
+}
+
+func (n *Duration) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &durationCodec{&n.Duration}, &n.Valid, n.policy())
+}
+
+func (n Duration) MarshalText() ([]byte, error) {
+	return marshalText(durationCodec{&n.Duration}, n.valid())
+}
+
+func (n *Duration) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &durationCodec{&n.Duration}, &n.Valid, n.policy())
+}
+
+func (n Duration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, durationCodec{&n.Duration}, n.valid())
+}
+
+func (n *Duration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &durationCodec{&n.Duration}, &n.Valid, n.policy())
+}
+
+func (n Duration) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, durationCodec{&n.Duration}, n.valid())
+}
+
+func (n *Duration) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &durationCodec{&n.Duration}, &n.Valid, n.policy())
+}
+
+type durationCodec struct {
+	d *time.Duration
+}
+
+func (c durationCodec) IsZero() bool {
+	return *c.d == 0
+}
+
+func (c durationCodec) MarshalText() ([]byte, error) {
+	if DurationEncoding == DurationISO8601 {
+		return []byte(formatISO8601Duration(*c.d)), nil
+	}
+	return []byte(c.d.String()), nil
+}
+
+func (c *durationCodec) UnmarshalText(text []byte) error {
+	d, err := parseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*c.d = d
+	return nil
+}
+
+func (c durationCodec) MarshalJSON() ([]byte, error) {
+	text, _ := c.MarshalText()
+	return json.Marshal(string(text))
+}
+
+func (c *durationCodec) UnmarshalJSON(data []byte) error {
+	if numberRegexp.Match(data) {
+		d, err := durationFromNumber(string(data))
+		if err != nil {
+			return err
+		}
+		*c.d = d
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("nullable: cannot decode %s into duration: %w", data, err)
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+func toDuration(value interface{}) (time.Duration, error) {
+	switch src := value.(type) {
+	case int64:
+		return durationFromNumber(strconv.FormatInt(src, 10))
+	case float64:
+		return durationFromNumber(strconv.FormatFloat(src, 'f', -1, 64))
+	case string:
+		return parseDuration(src)
+	case []byte:
+		return parseDuration(string(src))
+	}
+	return 0, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type Duration", value)
+}
+
+// parseDuration parses Go duration strings, ISO 8601 durations, the default
+// output of Postgres intervals and numbers of DurationNumberUnit.
+func parseDuration(s string) (time.Duration, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+	if numberRegexp.MatchString(s) {
+		return durationFromNumber(s)
+	}
+	if d, ok := parseISO8601Duration(s); ok {
+		return d, nil
+	}
+	if d, ok := parseIntervalDuration(s); ok {
+		return d, nil
+	}
+	return 0, fmt.Errorf("nullable: invalid duration %q", s)
+}
+
+func durationFromNumber(s string) (time.Duration, error) {
+	f, _, err := big.ParseFloat(s, 10, 256, big.ToNearestEven)
+	if err != nil {
+		return 0, fmt.Errorf("nullable: invalid duration %q", s)
+	}
+	d, acc := f.Mul(f, big.NewFloat(float64(DurationNumberUnit))).Int64()
+	if acc != big.Exact && (d == math.MaxInt64 || d == math.MinInt64) {
+		return 0, fmt.Errorf("%w: invalid duration %q", ErrOutOfRange, s)
+	}
+	return time.Duration(d), nil
+}
+
+func formatISO8601Duration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+	b.WriteString("PT")
+	if h := u / uint64(time.Hour); h > 0 {
+		b.WriteString(strconv.FormatUint(h, 10) + "H")
+	}
+	if m := u / uint64(time.Minute) % 60; m > 0 {
+		b.WriteString(strconv.FormatUint(m, 10) + "M")
+	}
+	if ns := u % uint64(time.Minute); ns > 0 {
+		b.WriteString(strconv.FormatFloat(float64(ns)/float64(time.Second), 'f', -1, 64) + "S")
+	}
+	return b.String()
+}
+
+var iso8601DurationRegexp = regexp.MustCompile(
+	`^([-+])?P(?:([0-9.]+)Y)?(?:([0-9.]+)M)?(?:([0-9.]+)W)?(?:([0-9.]+)D)?` +
+		`(?:T(?:([0-9.]+)H)?(?:([0-9.]+)M)?(?:([0-9.]+)S)?)?$`,
+)
+
+func parseISO8601Duration(s string) (time.Duration, bool) {
+	m := iso8601DurationRegexp.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, false
+	}
+	units := []time.Duration{year, month, 7 * day, day, time.Hour, time.Minute, time.Second}
+	var total float64
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(m[i+2], 64)
+		if err != nil {
+			return 0, false
+		}
+		total += v * float64(unit)
+	}
+	if m[1] == "-" {
+		total = -total
+	}
+	return roundDuration(total)
+}
+
+var intervalRegexp = regexp.MustCompile(
+	`^(?:([-+]?\d+) years? ?)?(?:([-+]?\d+) mons? ?)?(?:([-+]?\d+) days? ?)?` +
+		`(?:([-+])?(\d+):(\d{2}):(\d{2}(?:\.\d+)?))?$`,
+)
+
+// parseIntervalDuration parses the default (postgres) output style of
+// intervals, such as "1 day 02:03:04.5".
+func parseIntervalDuration(s string) (time.Duration, bool) {
+	m := intervalRegexp.FindStringSubmatch(s)
+	if m == nil || s == "" {
+		return 0, false
+	}
+	var total float64
+	for i, unit := range []time.Duration{year, month, day} {
+		if m[i+1] != "" {
+			v, _ := strconv.ParseFloat(m[i+1], 64)
+			total += v * float64(unit)
+		}
+	}
+	if m[5] != "" {
+		h, _ := strconv.ParseFloat(m[5], 64)
+		mins, _ := strconv.ParseFloat(m[6], 64)
+		sec, _ := strconv.ParseFloat(m[7], 64)
+		clock := h*float64(time.Hour) + mins*float64(time.Minute) + sec*float64(time.Second)
+		if m[4] == "-" {
+			clock = -clock
+		}
+		total += clock
+	}
+	return roundDuration(total)
+}
+
+func roundDuration(f float64) (time.Duration, bool) {
+	if f > math.MaxInt64 || f < math.MinInt64 {
+		return 0, false
+	}
+	return time.Duration(math.Round(f)), true
+}
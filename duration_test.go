@@ -0,0 +1,282 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const durationRef = time.Hour + 30*time.Minute
+
+func TestDuration_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding nullable.DurationFormat
+		value    interface{}
+		want     []byte
+	}{
+		{
+			name:  "should return null",
+			value: nullable.Duration{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should return a Go duration",
+			value: *nullable.NewDuration(durationRef),
+			want:  []byte(`"1h30m0s"`),
+		},
+		{
+			name:     "should return an ISO 8601 duration",
+			encoding: nullable.DurationISO8601,
+			value:    *nullable.NewDuration(durationRef),
+			want:     []byte(`"PT1H30M"`),
+		},
+		{
+			name:     "should return a negative ISO 8601 duration with fractional seconds",
+			encoding: nullable.DurationISO8601,
+			value:    *nullable.NewDuration(-(time.Minute + 1500*time.Millisecond)),
+			want:     []byte(`"-PT1M1.5S"`),
+		},
+		{
+			name:     "should return an empty ISO 8601 duration",
+			encoding: nullable.DurationISO8601,
+			value:    *nullable.NewDuration(0),
+			want:     []byte(`"PT0S"`),
+		},
+		{
+			name: "should marshal the given duration from a struct",
+			value: &struct {
+				ID    int               `json:"id"`
+				Value nullable.Duration `json:"value"`
+			}{
+				ID:    100,
+				Value: *nullable.NewDuration(time.Second),
+			},
+			want: []byte(`{"id":100,"value":"1s"}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(encoding nullable.DurationFormat) { nullable.DurationEncoding = encoding }(nullable.DurationEncoding)
+			nullable.DurationEncoding = tt.encoding
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		unit    time.Duration
+		data    []byte
+		want    nullable.Duration
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			data: []byte("null"),
+			want: nullable.Duration{},
+		},
+		{
+			name: "should unmarshal a Go duration",
+			data: []byte(`"1h30m"`),
+			want: *nullable.NewDuration(durationRef),
+		},
+		{
+			name: "should unmarshal an ISO 8601 duration",
+			data: []byte(`"PT1H30M"`),
+			want: *nullable.NewDuration(durationRef),
+		},
+		{
+			name: "should unmarshal an ISO 8601 duration with days",
+			data: []byte(`"P1DT0.5S"`),
+			want: *nullable.NewDuration(24*time.Hour + 500*time.Millisecond),
+		},
+		{
+			name: "should unmarshal nanoseconds",
+			data: []byte(`5400000000000`),
+			want: *nullable.NewDuration(durationRef),
+		},
+		{
+			name: "should unmarshal seconds",
+			unit: time.Second,
+			data: []byte(`5400`),
+			want: *nullable.NewDuration(durationRef),
+		},
+		{
+			name:    "should return an error due to an empty ISO 8601 duration",
+			data:    []byte(`"PT"`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			data:    []byte(`true`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(unit time.Duration) { nullable.DurationNumberUnit = unit }(nullable.DurationNumberUnit)
+			if tt.unit != 0 {
+				nullable.DurationNumberUnit = tt.unit
+			}
+			var n nullable.Duration
+			err := json.Unmarshal(tt.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuration_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    nullable.Duration
+		wantErr bool
+	}{
+		{
+			name:  "should return a null duration",
+			value: nil,
+			want:  nullable.Duration{},
+		},
+		{
+			name:  "should scan a bigint",
+			value: int64(durationRef),
+			want:  *nullable.NewDuration(durationRef),
+		},
+		{
+			name:  "should scan a Go duration",
+			value: "1h30m",
+			want:  *nullable.NewDuration(durationRef),
+		},
+		{
+			name:  "should scan a Postgres interval",
+			value: []byte("01:30:00"),
+			want:  *nullable.NewDuration(durationRef),
+		},
+		{
+			name:  "should scan a Postgres interval with days",
+			value: []byte("1 day 01:30:00.5"),
+			want:  *nullable.NewDuration(24*time.Hour + durationRef + 500*time.Millisecond),
+		},
+		{
+			name:  "should scan a Postgres interval with mixed signs",
+			value: "-1 days +01:30:00",
+			want:  *nullable.NewDuration(-24*time.Hour + durationRef),
+		},
+		{
+			name:  "should scan a Postgres interval with months",
+			value: "1 mon",
+			want:  *nullable.NewDuration(30 * 24 * time.Hour),
+		},
+		{
+			name:    "should return an error due to an invalid interval",
+			value:   "1 fortnight",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unsupported format",
+			value:   true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Duration
+			err := n.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuration_Value(t *testing.T) {
+	tests := []struct {
+		name     string
+		unit     time.Duration
+		interval bool
+		value    nullable.Duration
+		want     driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.Duration{},
+			want:  nil,
+		},
+		{
+			name:  "should return nanoseconds",
+			value: *nullable.NewDuration(durationRef),
+			want:  int64(durationRef),
+		},
+		{
+			name:  "should return seconds",
+			unit:  time.Second,
+			value: *nullable.NewDuration(durationRef),
+			want:  int64(5400),
+		},
+		{
+			name:  "should round to seconds",
+			unit:  time.Second,
+			value: *nullable.NewDuration(1500 * time.Millisecond),
+			want:  int64(2),
+		},
+		{
+			name:  "should round negative durations to seconds",
+			unit:  time.Second,
+			value: *nullable.NewDuration(-1400 * time.Millisecond),
+			want:  int64(-1),
+		},
+		{
+			name:     "should return an interval",
+			interval: true,
+			value:    *nullable.NewDuration(durationRef),
+			want:     "PT1H30M",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(unit time.Duration, interval bool) {
+				nullable.DurationNumberUnit, nullable.DurationIntervalValue = unit, interval
+			}(nullable.DurationNumberUnit, nullable.DurationIntervalValue)
+			if tt.unit != 0 {
+				nullable.DurationNumberUnit = tt.unit
+			}
+			nullable.DurationIntervalValue = tt.interval
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
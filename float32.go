@@ -0,0 +1,100 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"strconv"
+)
+
+type Float32 struct {
+	Float32 float32
+	Valid   bool
+	Policy  NullPolicy
+}
+
+func NewFloat32(v float32) *Float32 {
+	return &Float32{
+		Float32: v,
+		Valid:   true,
+	}
+}
+
+func Float32From(v float32) Float32 {
+	return *NewFloat32(v)
+}
+
+func Float32FromPtr(v *float32) Float32 {
+	if v == nil {
+		return Float32{}
+	}
+	return Float32From(*v)
+}
+
+func (n Float32) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Float32) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Float32)
+}
+
+func (n Float32) Get() (float32, bool) {
+	return get(n.Float32, n.valid())
+}
+
+func (n Float32) ValueOr(v float32) float32 {
+	return valueOr(n.Float32, n.valid(), v)
+}
+
+func (n Float32) MustGet() float32 {
+	return mustGet(n.Float32, n.valid())
+}
+
+func (n Float32) Ptr() *float32 {
+	return ptr(n.Float32, n.valid())
+}
+
+func (n *Float32) Scan(value interface{}) error {
+	return scanFloat32(value, &n.Float32, &n.Valid, n.policy())
+}
+
+// Value returns the float64 closest to the decimal representation of the
+// float32, so 0.1 is sent as 0.1 rather than 0.10000000149011612.
+func (n Float32) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return strconv.ParseFloat(strconv.FormatFloat(float64(n.Float32), 'g', -1, 32), 64)
+}
+
+func (n Float32) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Float32, n.valid())
+}
+
+func (n *Float32) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Float32, &n.Valid, n.policy())
+}
+
+func (n Float32) MarshalText() ([]byte, error) {
+	return marshalText(n.Float32, n.valid())
+}
+
+func (n *Float32) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Float32, &n.Valid, n.policy())
+}
+
+func (n Float32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Float32, n.valid())
+}
+
+func (n *Float32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Float32, &n.Valid, n.policy())
+}
+
+func (n Float32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Float32, n.valid())
+}
+
+func (n *Float32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Float32, &n.Valid, n.policy())
+}
@@ -0,0 +1,334 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/diegohordi/nullable"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestFloat32_MarshalJSON(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should return null",
+			fields: fields{
+				value: nullable.Float32{
+					Float32: 0,
+					Valid:   false,
+				}},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name: "should return the given float32",
+			fields: fields{
+				value: *nullable.NewFloat32(math.MaxFloat32),
+			},
+			want:    []byte(fmt.Sprintf("%v", float32(math.MaxFloat32))),
+			wantErr: false,
+		},
+		{
+			name: "should marshal the given float32 from a struct",
+			fields: fields{
+				value: &struct {
+					ID    int              `json:"id"`
+					Value nullable.Float32 `json:"value"`
+				}{
+					ID:    100,
+					Value: *nullable.NewFloat32(100),
+				},
+			},
+			want:    []byte(`{"id":100,"value":100}`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloat32_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		holder  interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			args: args{
+				data: []byte("null"),
+			},
+			holder: &nullable.Float32{},
+			want: &nullable.Float32{
+				Float32: 0,
+				Valid:   false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal into a struct",
+			args: args{
+				data: []byte(`{"id": 100, "value":120}`),
+			},
+			holder: &struct {
+				ID    int              `json:"id"`
+				Value nullable.Float32 `json:"value"`
+			}{},
+			want: &struct {
+				ID    int              `json:"id"`
+				Value nullable.Float32 `json:"value"`
+			}{
+				ID:    100,
+				Value: *nullable.NewFloat32(120),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an unexpected value",
+			args: args{
+				data: []byte(`{"id": 100, "value":"test"}`),
+			},
+			holder: &struct {
+				ID    int              `json:"id"`
+				Value nullable.Float32 `json:"value"`
+			}{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := json.Unmarshal(tt.args.data, tt.holder)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.holder, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", tt.holder, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloat32_Scan(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    nullable.Float32
+		wantErr bool
+	}{
+		{
+			name: "should return a nullable float32 with 0 as value",
+			fields: fields{
+				value: nil,
+			},
+			want: nullable.Float32{
+				Float32: 0,
+				Valid:   false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable float32 with the given float64 as its value",
+			fields: fields{
+				value: 0.1,
+			},
+			want:    *nullable.NewFloat32(0.1),
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable float32 with the given bytes as its value",
+			fields: fields{
+				value: []byte("1.5"),
+			},
+			want:    *nullable.NewFloat32(1.5),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an overflow",
+			fields: fields{
+				value: math.MaxFloat64,
+			},
+			want:    nullable.Float32{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an invalid string",
+			fields: fields{
+				value: "test",
+			},
+			want:    nullable.Float32{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an unsupported format",
+			fields: fields{
+				value: make(chan string),
+			},
+			want:    nullable.Float32{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Float32
+			err := n.Scan(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloat32_Value(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   driver.Valuer
+		want    driver.Value
+		wantErr bool
+	}{
+		{
+			name:    "should return nil",
+			value:   nullable.Float32{},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "should return the given float32 without precision artifacts",
+			value:   *nullable.NewFloat32(0.1),
+			want:    0.1,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloat32_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   nullable.Float32
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "should return an empty text",
+			value:   nullable.Float32{},
+			want:    []byte(""),
+			wantErr: false,
+		},
+		{
+			name:    "should return the given value as text",
+			value:   *nullable.NewFloat32(0.1),
+			want:    []byte("0.1"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloat32_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    nullable.Float32
+		wantErr bool
+	}{
+		{
+			name:    "should unmarshal an empty text as null",
+			text:    []byte(""),
+			want:    nullable.Float32{},
+			wantErr: false,
+		},
+		{
+			name:    "should unmarshal the given text",
+			text:    []byte("0.1"),
+			want:    *nullable.NewFloat32(0.1),
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			text:    []byte("test"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Float32
+			err := n.UnmarshalText(tt.text)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
@@ -1,38 +1,164 @@
 package nullable
 
 import (
-	"bytes"
 	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"math"
 )
 
+// NonFiniteFormat defines how the non-finite Float64 values, NaN and ±Inf,
+// which have no JSON representation, are handled.
+type NonFiniteFormat uint8
+
+const (
+	// NonFiniteError returns an error when marshaling non-finite values as
+	// JSON, like encoding/json.
+	NonFiniteError NonFiniteFormat = iota
+	// NonFiniteNull handles non-finite values as null, including the ones
+	// scanned from the database.
+	NonFiniteNull
+	// NonFiniteString encodes non-finite values as the JSON strings "NaN",
+	// "Infinity" and "-Infinity", which are accepted when decoding as well.
+	NonFiniteString
+)
+
+// Float64NonFinite defines how non-finite Float64 values are handled.
+var Float64NonFinite = NonFiniteError
+
 type Float64 struct {
 	sql.NullFloat64
+	Policy NullPolicy
 }
 
 func NewFloat64(v float64) *Float64 {
-	return &Float64{sql.NullFloat64{
+	return &Float64{NullFloat64: sql.NullFloat64{
 		Float64: v,
 		Valid:   true,
 	}}
 }
 
-func (n Float64) MarshalJSON() ([]byte, error) {
-	if !n.Valid {
-		return jsonNullBytes, nil
-	}
-	return json.Marshal(n.Float64)
+func Float64From(v float64) Float64 {
+	return *NewFloat64(v)
 }
 
-func (n *Float64) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, jsonNullBytes) {
-		n.Valid = false
-		return nil
+func Float64FromPtr(v *float64) Float64 {
+	if v == nil {
+		return Float64{}
 	}
-	err := json.Unmarshal(data, &n.Float64)
-	if err != nil {
+	return Float64From(*v)
+}
+
+func (n Float64) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Float64) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Float64) && !nonFiniteNull(n.Float64)
+}
+
+func (n Float64) Get() (float64, bool) {
+	return get(n.Float64, n.valid())
+}
+
+func (n Float64) ValueOr(v float64) float64 {
+	return valueOr(n.Float64, n.valid(), v)
+}
+
+func (n Float64) MustGet() float64 {
+	return mustGet(n.Float64, n.valid())
+}
+
+func (n Float64) Ptr() *float64 {
+	return ptr(n.Float64, n.valid())
+}
+
+func (n *Float64) Scan(value interface{}) error {
+	if err := scanValue(value, &n.Float64, &n.Valid, n.policy(), convertAssign[float64]); err != nil {
 		return err
 	}
-	n.Valid = true
+	if nonFiniteNull(n.Float64) {
+		n.Float64, n.Valid = 0, false
+	}
 	return nil
 }
+
+func (n Float64) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return n.NullFloat64.Value()
+}
+
+func (n Float64) MarshalJSON() ([]byte, error) {
+	return marshalJSON(float64Codec{&n.Float64}, n.valid())
+}
+
+func (n *Float64) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &float64Codec{&n.Float64}, &n.Valid, n.policy())
+}
+
+func (n Float64) MarshalText() ([]byte, error) {
+	return marshalText(n.Float64, n.valid())
+}
+
+func (n *Float64) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Float64, &n.Valid, n.policy())
+}
+
+func (n Float64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Float64, n.valid())
+}
+
+func (n *Float64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Float64, &n.Valid, n.policy())
+}
+
+func (n Float64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Float64, n.valid())
+}
+
+func (n *Float64) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Float64, &n.Valid, n.policy())
+}
+
+func nonFiniteNull(f float64) bool {
+	return Float64NonFinite == NonFiniteNull && (math.IsNaN(f) || math.IsInf(f, 0))
+}
+
+var nonFiniteStrings = map[string]float64{
+	`"NaN"`:       math.NaN(),
+	`"Infinity"`:  math.Inf(1),
+	`"-Infinity"`: math.Inf(-1),
+}
+
+type float64Codec struct {
+	f *float64
+}
+
+func (c float64Codec) IsZero() bool {
+	return *c.f == 0
+}
+
+func (c float64Codec) MarshalJSON() ([]byte, error) {
+	if Float64NonFinite == NonFiniteString {
+		switch {
+		case math.IsNaN(*c.f):
+			return []byte(`"NaN"`), nil
+		case math.IsInf(*c.f, 1):
+			return []byte(`"Infinity"`), nil
+		case math.IsInf(*c.f, -1):
+			return []byte(`"-Infinity"`), nil
+		}
+	}
+	return json.Marshal(*c.f)
+}
+
+func (c *float64Codec) UnmarshalJSON(data []byte) error {
+	if f, ok := nonFiniteStrings[string(data)]; ok && Float64NonFinite == NonFiniteString {
+		*c.f = f
+		return nil
+	}
+	return decodeJSON(data, c.f)
+}
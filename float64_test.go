@@ -198,3 +198,221 @@ func TestFloat64_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestFloat64_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   nullable.Float64
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "should return an empty text",
+			value:   nullable.Float64{},
+			want:    []byte(""),
+			wantErr: false,
+		},
+		{
+			name:    "should return the given value as text",
+			value:   *nullable.NewFloat64(1.5),
+			want:    []byte("1.5"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloat64_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    nullable.Float64
+		wantErr bool
+	}{
+		{
+			name:    "should unmarshal an empty text as null",
+			text:    []byte(""),
+			want:    nullable.Float64{},
+			wantErr: false,
+		},
+		{
+			name:    "should unmarshal the given text",
+			text:    []byte("1.5"),
+			want:    *nullable.NewFloat64(1.5),
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			text:    []byte("test"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Float64
+			err := n.UnmarshalText(tt.text)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloat64_NonFinite(t *testing.T) {
+	tests := []struct {
+		name      string
+		nonFinite nullable.NonFiniteFormat
+		value     float64
+		wantJSON  []byte
+		wantErr   bool
+	}{
+		{
+			name:      "should return an error due to NaN",
+			nonFinite: nullable.NonFiniteError,
+			value:     math.NaN(),
+			wantErr:   true,
+		},
+		{
+			name:      "should return null for NaN",
+			nonFinite: nullable.NonFiniteNull,
+			value:     math.NaN(),
+			wantJSON:  []byte("null"),
+		},
+		{
+			name:      "should return null for infinity",
+			nonFinite: nullable.NonFiniteNull,
+			value:     math.Inf(-1),
+			wantJSON:  []byte("null"),
+		},
+		{
+			name:      "should return a NaN string",
+			nonFinite: nullable.NonFiniteString,
+			value:     math.NaN(),
+			wantJSON:  []byte(`"NaN"`),
+		},
+		{
+			name:      "should return an infinity string",
+			nonFinite: nullable.NonFiniteString,
+			value:     math.Inf(1),
+			wantJSON:  []byte(`"Infinity"`),
+		},
+		{
+			name:      "should return a negative infinity string",
+			nonFinite: nullable.NonFiniteString,
+			value:     math.Inf(-1),
+			wantJSON:  []byte(`"-Infinity"`),
+		},
+		{
+			name:      "should return a finite number",
+			nonFinite: nullable.NonFiniteString,
+			value:     1.5,
+			wantJSON:  []byte("1.5"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(nonFinite nullable.NonFiniteFormat) { nullable.Float64NonFinite = nonFinite }(nullable.Float64NonFinite)
+			nullable.Float64NonFinite = tt.nonFinite
+			got, err := json.Marshal(nullable.NewFloat64(tt.value))
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.wantJSON) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.wantJSON)
+				return
+			}
+			var n nullable.Float64
+			if err := json.Unmarshal(got, &n); err != nil {
+				t.Errorf("UnmarshalJSON() error = %v", err)
+				return
+			}
+			if tt.nonFinite == nullable.NonFiniteNull {
+				if n.Valid {
+					t.Errorf("UnmarshalJSON() got = %v, want null", n)
+				}
+				return
+			}
+			if !n.Valid || !(n.Float64 == tt.value || math.IsNaN(n.Float64) && math.IsNaN(tt.value)) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.value)
+			}
+		})
+	}
+}
+
+func TestFloat64_ScanNonFinite(t *testing.T) {
+	tests := []struct {
+		name      string
+		nonFinite nullable.NonFiniteFormat
+		value     interface{}
+		wantValid bool
+	}{
+		{
+			name:      "should scan NaN",
+			nonFinite: nullable.NonFiniteError,
+			value:     math.NaN(),
+			wantValid: true,
+		},
+		{
+			name:      "should scan NaN as null",
+			nonFinite: nullable.NonFiniteNull,
+			value:     math.NaN(),
+			wantValid: false,
+		},
+		{
+			name:      "should scan textual infinity as null",
+			nonFinite: nullable.NonFiniteNull,
+			value:     []byte("Infinity"),
+			wantValid: false,
+		},
+		{
+			name:      "should scan NaN when encoded as string",
+			nonFinite: nullable.NonFiniteString,
+			value:     []byte("NaN"),
+			wantValid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(nonFinite nullable.NonFiniteFormat) { nullable.Float64NonFinite = nonFinite }(nullable.Float64NonFinite)
+			nullable.Float64NonFinite = tt.nonFinite
+			var n nullable.Float64
+			if err := n.Scan(tt.value); err != nil {
+				t.Errorf("Scan() error = %v", err)
+				return
+			}
+			if n.Valid != tt.wantValid {
+				t.Errorf("Scan() got = %v, want valid %v", n, tt.wantValid)
+			}
+			got, err := n.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if (got != nil) != tt.wantValid {
+				t.Errorf("Value() got = %v, want valid %v", got, tt.wantValid)
+			}
+		})
+	}
+}
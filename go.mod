@@ -1,3 +1,3 @@
 module github.com/diegohordi/nullable
 
-go 1.17
+go 1.24
@@ -0,0 +1,138 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"net"
+)
+
+// HardwareAddr is a nullable MAC address, for Postgres macaddr and macaddr8
+// columns, encoded as lowercase, colon separated hexadecimal digits.
+type HardwareAddr struct {
+	HardwareAddr net.HardwareAddr
+	Valid        bool
+	Policy       NullPolicy
+}
+
+func NewHardwareAddr(v net.HardwareAddr) *HardwareAddr {
+	return &HardwareAddr{
+		HardwareAddr: v,
+		Valid:        true,
+	}
+}
+
+func HardwareAddrFrom(v net.HardwareAddr) HardwareAddr {
+	return *NewHardwareAddr(v)
+}
+
+func HardwareAddrFromPtr(v *net.HardwareAddr) HardwareAddr {
+	if v == nil {
+		return HardwareAddr{}
+	}
+	return HardwareAddrFrom(*v)
+}
+
+func (n HardwareAddr) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n HardwareAddr) valid() bool {
+	return n.Valid && !n.policy().nulls(n.HardwareAddr)
+}
+
+func (n HardwareAddr) Get() (net.HardwareAddr, bool) {
+	return get(n.HardwareAddr, n.valid())
+}
+
+func (n HardwareAddr) ValueOr(v net.HardwareAddr) net.HardwareAddr {
+	return valueOr(n.HardwareAddr, n.valid(), v)
+}
+
+func (n HardwareAddr) MustGet() net.HardwareAddr {
+	return mustGet(n.HardwareAddr, n.valid())
+}
+
+func (n HardwareAddr) Ptr() *net.HardwareAddr {
+	return ptr(n.HardwareAddr, n.valid())
+}
+
+func (n *HardwareAddr) Scan(value interface{}) error {
+	return scanValue(value, &n.HardwareAddr, &n.Valid, n.policy(), toHardwareAddr)
+}
+
+func (n HardwareAddr) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return n.HardwareAddr.String(), nil
+}
+
+func (n HardwareAddr) MarshalJSON() ([]byte, error) {
+	return marshalJSON(hardwareAddrText(n.HardwareAddr), n.valid())
+}
+
+func (n *HardwareAddr) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, (*hardwareAddrText)(&n.HardwareAddr), &n.Valid, n.policy())
+}
+
+func (n HardwareAddr) MarshalText() ([]byte, error) {
+	return marshalText(hardwareAddrText(n.HardwareAddr), n.valid())
+}
+
+func (n *HardwareAddr) UnmarshalText(text []byte) error {
+	return unmarshalText(text, (*hardwareAddrText)(&n.HardwareAddr), &n.Valid, n.policy())
+}
+
+func (n HardwareAddr) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, hardwareAddrText(n.HardwareAddr), n.valid())
+}
+
+func (n *HardwareAddr) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, (*hardwareAddrText)(&n.HardwareAddr), &n.Valid, n.policy())
+}
+
+func (n HardwareAddr) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, hardwareAddrText(n.HardwareAddr), n.valid())
+}
+
+func (n *HardwareAddr) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, (*hardwareAddrText)(&n.HardwareAddr), &n.Valid, n.policy())
+}
+
+type hardwareAddrText net.HardwareAddr
+
+func (h hardwareAddrText) IsZero() bool {
+	return len(h) == 0
+}
+
+func (h hardwareAddrText) MarshalText() ([]byte, error) {
+	return []byte(net.HardwareAddr(h).String()), nil
+}
+
+func (h *hardwareAddrText) UnmarshalText(text []byte) error {
+	v, err := parseHardwareAddr(string(text))
+	if err != nil {
+		return err
+	}
+	*h = hardwareAddrText(v)
+	return nil
+}
+
+func toHardwareAddr(value interface{}) (net.HardwareAddr, error) {
+	switch src := value.(type) {
+	case string:
+		return parseHardwareAddr(src)
+	case []byte:
+		return parseHardwareAddr(string(src))
+	}
+	return nil, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type HardwareAddr", value)
+}
+
+func parseHardwareAddr(s string) (net.HardwareAddr, error) {
+	h, err := net.ParseMAC(s)
+	if err != nil {
+		return nil, fmt.Errorf("nullable: invalid hardware address %q", s)
+	}
+	return h, nil
+}
@@ -0,0 +1,161 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"net"
+	"reflect"
+	"testing"
+)
+
+var hardwareAddrRef = net.HardwareAddr{0x08, 0x00, 0x2b, 0x01, 0x02, 0x03}
+
+func TestHardwareAddr_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []byte
+	}{
+		{
+			name:  "should return null",
+			value: nullable.HardwareAddr{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should return the address",
+			value: *nullable.NewHardwareAddr(hardwareAddrRef),
+			want:  []byte(`"08:00:2b:01:02:03"`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHardwareAddr_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    nullable.HardwareAddr
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			data: []byte("null"),
+			want: nullable.HardwareAddr{},
+		},
+		{
+			name: "should unmarshal the address",
+			data: []byte(`"08-00-2B-01-02-03"`),
+			want: *nullable.NewHardwareAddr(hardwareAddrRef),
+		},
+		{
+			name:    "should return an error due to an invalid address",
+			data:    []byte(`"08:00:2b"`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.HardwareAddr
+			err := json.Unmarshal(tt.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestHardwareAddr_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    nullable.HardwareAddr
+		wantErr bool
+	}{
+		{
+			name:  "should return a null address",
+			value: nil,
+			want:  nullable.HardwareAddr{},
+		},
+		{
+			name:  "should scan a macaddr",
+			value: []byte("08:00:2b:01:02:03"),
+			want:  *nullable.NewHardwareAddr(hardwareAddrRef),
+		},
+		{
+			name:  "should scan a macaddr8",
+			value: "08:00:2b:ff:fe:01:02:03",
+			want:  *nullable.NewHardwareAddr(net.HardwareAddr{0x08, 0x00, 0x2b, 0xff, 0xfe, 0x01, 0x02, 0x03}),
+		},
+		{
+			name:    "should return an error due to an unsupported type",
+			value:   int64(1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.HardwareAddr
+			err := n.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestHardwareAddr_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.HardwareAddr
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.HardwareAddr{},
+			want:  nil,
+		},
+		{
+			name:  "should return the address",
+			value: *nullable.NewHardwareAddr(hardwareAddrRef),
+			want:  "08:00:2b:01:02:03",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"strconv"
+)
+
+type Int struct {
+	Int    int
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewInt(v int) *Int {
+	return &Int{
+		Int:   v,
+		Valid: true,
+	}
+}
+
+func IntFrom(v int) Int {
+	return *NewInt(v)
+}
+
+func IntFromPtr(v *int) Int {
+	if v == nil {
+		return Int{}
+	}
+	return IntFrom(*v)
+}
+
+func (n Int) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Int) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Int)
+}
+
+func (n Int) Get() (int, bool) {
+	return get(n.Int, n.valid())
+}
+
+func (n Int) ValueOr(v int) int {
+	return valueOr(n.Int, n.valid(), v)
+}
+
+func (n Int) MustGet() int {
+	return mustGet(n.Int, n.valid())
+}
+
+func (n Int) Ptr() *int {
+	return ptr(n.Int, n.valid())
+}
+
+func (n *Int) Scan(value interface{}) error {
+	return scanInt(value, &n.Int, &n.Valid, n.policy(), strconv.IntSize)
+}
+
+func (n Int) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return int64(n.Int), nil
+}
+
+func (n Int) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Int, n.valid())
+}
+
+func (n *Int) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Int, &n.Valid, n.policy())
+}
+
+func (n Int) MarshalText() ([]byte, error) {
+	return marshalText(n.Int, n.valid())
+}
+
+func (n *Int) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Int, &n.Valid, n.policy())
+}
+
+func (n Int) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Int, n.valid())
+}
+
+func (n *Int) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Int, &n.Valid, n.policy())
+}
+
+func (n Int) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Int, n.valid())
+}
+
+func (n *Int) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Int, &n.Valid, n.policy())
+}
@@ -1,38 +1,97 @@
 package nullable
 
 import (
-	"bytes"
 	"database/sql"
-	"encoding/json"
+	"database/sql/driver"
+	"encoding/xml"
 )
 
 type Int16 struct {
 	sql.NullInt16
+	Policy NullPolicy
 }
 
 func NewInt16(v int16) *Int16 {
-	return &Int16{sql.NullInt16{
+	return &Int16{NullInt16: sql.NullInt16{
 		Int16: v,
 		Valid: true,
 	}}
 }
 
-func (n Int16) MarshalJSON() ([]byte, error) {
-	if !n.Valid {
-		return jsonNullBytes, nil
-	}
-	return json.Marshal(n.Int16)
+func Int16From(v int16) Int16 {
+	return *NewInt16(v)
 }
 
-func (n *Int16) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, jsonNullBytes) {
-		n.Valid = false
-		return nil
+func Int16FromPtr(v *int16) Int16 {
+	if v == nil {
+		return Int16{}
 	}
-	err := json.Unmarshal(data, &n.Int16)
-	if err != nil {
-		return err
+	return Int16From(*v)
+}
+
+func (n Int16) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Int16) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Int16)
+}
+
+func (n Int16) Get() (int16, bool) {
+	return get(n.Int16, n.valid())
+}
+
+func (n Int16) ValueOr(v int16) int16 {
+	return valueOr(n.Int16, n.valid(), v)
+}
+
+func (n Int16) MustGet() int16 {
+	return mustGet(n.Int16, n.valid())
+}
+
+func (n Int16) Ptr() *int16 {
+	return ptr(n.Int16, n.valid())
+}
+
+func (n *Int16) Scan(value interface{}) error {
+	return scanValue(value, &n.Int16, &n.Valid, n.policy(), convertAssign[int16])
+}
+
+func (n Int16) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
 	}
-	n.Valid = true
-	return nil
+	return n.NullInt16.Value()
+}
+
+func (n Int16) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Int16, n.valid())
+}
+
+func (n *Int16) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Int16, &n.Valid, n.policy())
+}
+
+func (n Int16) MarshalText() ([]byte, error) {
+	return marshalText(n.Int16, n.valid())
+}
+
+func (n *Int16) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Int16, &n.Valid, n.policy())
+}
+
+func (n Int16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Int16, n.valid())
+}
+
+func (n *Int16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Int16, &n.Valid, n.policy())
+}
+
+func (n Int16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Int16, n.valid())
+}
+
+func (n *Int16) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Int16, &n.Valid, n.policy())
 }
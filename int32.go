@@ -1,38 +1,97 @@
 package nullable
 
 import (
-	"bytes"
 	"database/sql"
-	"encoding/json"
+	"database/sql/driver"
+	"encoding/xml"
 )
 
 type Int32 struct {
 	sql.NullInt32
+	Policy NullPolicy
 }
 
 func NewInt32(v int32) *Int32 {
-	return &Int32{sql.NullInt32{
+	return &Int32{NullInt32: sql.NullInt32{
 		Int32: v,
 		Valid: true,
 	}}
 }
 
-func (n Int32) MarshalJSON() ([]byte, error) {
-	if !n.Valid {
-		return jsonNullBytes, nil
-	}
-	return json.Marshal(n.Int32)
+func Int32From(v int32) Int32 {
+	return *NewInt32(v)
 }
 
-func (n *Int32) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, jsonNullBytes) {
-		n.Valid = false
-		return nil
+func Int32FromPtr(v *int32) Int32 {
+	if v == nil {
+		return Int32{}
 	}
-	err := json.Unmarshal(data, &n.Int32)
-	if err != nil {
-		return err
+	return Int32From(*v)
+}
+
+func (n Int32) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Int32) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Int32)
+}
+
+func (n Int32) Get() (int32, bool) {
+	return get(n.Int32, n.valid())
+}
+
+func (n Int32) ValueOr(v int32) int32 {
+	return valueOr(n.Int32, n.valid(), v)
+}
+
+func (n Int32) MustGet() int32 {
+	return mustGet(n.Int32, n.valid())
+}
+
+func (n Int32) Ptr() *int32 {
+	return ptr(n.Int32, n.valid())
+}
+
+func (n *Int32) Scan(value interface{}) error {
+	return scanValue(value, &n.Int32, &n.Valid, n.policy(), convertAssign[int32])
+}
+
+func (n Int32) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
 	}
-	n.Valid = true
-	return nil
+	return n.NullInt32.Value()
+}
+
+func (n Int32) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Int32, n.valid())
+}
+
+func (n *Int32) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Int32, &n.Valid, n.policy())
+}
+
+func (n Int32) MarshalText() ([]byte, error) {
+	return marshalText(n.Int32, n.valid())
+}
+
+func (n *Int32) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Int32, &n.Valid, n.policy())
+}
+
+func (n Int32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Int32, n.valid())
+}
+
+func (n *Int32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Int32, &n.Valid, n.policy())
+}
+
+func (n Int32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Int32, n.valid())
+}
+
+func (n *Int32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Int32, &n.Valid, n.policy())
 }
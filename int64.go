@@ -1,38 +1,97 @@
 package nullable
 
 import (
-	"bytes"
 	"database/sql"
-	"encoding/json"
+	"database/sql/driver"
+	"encoding/xml"
 )
 
 type Int64 struct {
 	sql.NullInt64
+	Policy NullPolicy
 }
 
 func NewInt64(v int64) *Int64 {
-	return &Int64{sql.NullInt64{
+	return &Int64{NullInt64: sql.NullInt64{
 		Int64: v,
 		Valid: true,
 	}}
 }
 
-func (n Int64) MarshalJSON() ([]byte, error) {
-	if !n.Valid {
-		return jsonNullBytes, nil
-	}
-	return json.Marshal(n.Int64)
+func Int64From(v int64) Int64 {
+	return *NewInt64(v)
 }
 
-func (n *Int64) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, jsonNullBytes) {
-		n.Valid = false
-		return nil
+func Int64FromPtr(v *int64) Int64 {
+	if v == nil {
+		return Int64{}
 	}
-	err := json.Unmarshal(data, &n.Int64)
-	if err != nil {
-		return err
+	return Int64From(*v)
+}
+
+func (n Int64) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Int64) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Int64)
+}
+
+func (n Int64) Get() (int64, bool) {
+	return get(n.Int64, n.valid())
+}
+
+func (n Int64) ValueOr(v int64) int64 {
+	return valueOr(n.Int64, n.valid(), v)
+}
+
+func (n Int64) MustGet() int64 {
+	return mustGet(n.Int64, n.valid())
+}
+
+func (n Int64) Ptr() *int64 {
+	return ptr(n.Int64, n.valid())
+}
+
+func (n *Int64) Scan(value interface{}) error {
+	return scanValue(value, &n.Int64, &n.Valid, n.policy(), convertAssign[int64])
+}
+
+func (n Int64) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
 	}
-	n.Valid = true
-	return nil
+	return n.NullInt64.Value()
+}
+
+func (n Int64) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Int64, n.valid())
+}
+
+func (n *Int64) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Int64, &n.Valid, n.policy())
+}
+
+func (n Int64) MarshalText() ([]byte, error) {
+	return marshalText(n.Int64, n.valid())
+}
+
+func (n *Int64) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Int64, &n.Valid, n.policy())
+}
+
+func (n Int64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Int64, n.valid())
+}
+
+func (n *Int64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Int64, &n.Valid, n.policy())
+}
+
+func (n Int64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Int64, n.valid())
+}
+
+func (n *Int64) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Int64, &n.Valid, n.policy())
 }
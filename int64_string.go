@@ -0,0 +1,32 @@
+package nullable
+
+// Int64String is an Int64 encoded as a JSON string, so that values beyond 2^53,
+// such as snowflake IDs, are not corrupted by JavaScript clients. Both strings
+// and numbers are accepted when decoding. Scan, Value and the text and XML
+// encodings are the same of Int64.
+type Int64String struct {
+	Int64
+}
+
+func NewInt64String(v int64) *Int64String {
+	return &Int64String{*NewInt64(v)}
+}
+
+func Int64StringFrom(v int64) Int64String {
+	return *NewInt64String(v)
+}
+
+func Int64StringFromPtr(v *int64) Int64String {
+	if v == nil {
+		return Int64String{}
+	}
+	return Int64StringFrom(*v)
+}
+
+func (n Int64String) MarshalJSON() ([]byte, error) {
+	return marshalJSON(jsonString[int64]{&n.Int64.Int64}, n.valid())
+}
+
+func (n *Int64String) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &jsonString[int64]{&n.Int64.Int64}, &n.Valid, n.policy())
+}
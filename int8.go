@@ -0,0 +1,97 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+type Int8 struct {
+	Int8   int8
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewInt8(v int8) *Int8 {
+	return &Int8{
+		Int8:  v,
+		Valid: true,
+	}
+}
+
+func Int8From(v int8) Int8 {
+	return *NewInt8(v)
+}
+
+func Int8FromPtr(v *int8) Int8 {
+	if v == nil {
+		return Int8{}
+	}
+	return Int8From(*v)
+}
+
+func (n Int8) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Int8) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Int8)
+}
+
+func (n Int8) Get() (int8, bool) {
+	return get(n.Int8, n.valid())
+}
+
+func (n Int8) ValueOr(v int8) int8 {
+	return valueOr(n.Int8, n.valid(), v)
+}
+
+func (n Int8) MustGet() int8 {
+	return mustGet(n.Int8, n.valid())
+}
+
+func (n Int8) Ptr() *int8 {
+	return ptr(n.Int8, n.valid())
+}
+
+func (n *Int8) Scan(value interface{}) error {
+	return scanInt(value, &n.Int8, &n.Valid, n.policy(), 8)
+}
+
+func (n Int8) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return int64(n.Int8), nil
+}
+
+func (n Int8) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Int8, n.valid())
+}
+
+func (n *Int8) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Int8, &n.Valid, n.policy())
+}
+
+func (n Int8) MarshalText() ([]byte, error) {
+	return marshalText(n.Int8, n.valid())
+}
+
+func (n *Int8) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Int8, &n.Valid, n.policy())
+}
+
+func (n Int8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Int8, n.valid())
+}
+
+func (n *Int8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Int8, &n.Valid, n.policy())
+}
+
+func (n Int8) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Int8, n.valid())
+}
+
+func (n *Int8) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Int8, &n.Valid, n.policy())
+}
@@ -0,0 +1,358 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/diegohordi/nullable"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestInt8_MarshalJSON(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should return null",
+			fields: fields{
+				value: nullable.Int8{
+					Int8:  0,
+					Valid: false,
+				}},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name: "should return the given int8",
+			fields: fields{
+				value: *nullable.NewInt8(math.MaxInt8),
+			},
+			want:    []byte(fmt.Sprintf("%v", math.MaxInt8)),
+			wantErr: false,
+		},
+		{
+			name: "should marshal the given int8 from a struct",
+			fields: fields{
+				value: &struct {
+					ID    int           `json:"id"`
+					Value nullable.Int8 `json:"value"`
+				}{
+					ID:    100,
+					Value: *nullable.NewInt8(100),
+				},
+			},
+			want:    []byte(`{"id":100,"value":100}`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt8_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		holder  interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			args: args{
+				data: []byte("null"),
+			},
+			holder: &nullable.Int8{},
+			want: &nullable.Int8{
+				Int8:  0,
+				Valid: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal into a struct",
+			args: args{
+				data: []byte(`{"id": 100, "value":120}`),
+			},
+			holder: &struct {
+				ID    int           `json:"id"`
+				Value nullable.Int8 `json:"value"`
+			}{},
+			want: &struct {
+				ID    int           `json:"id"`
+				Value nullable.Int8 `json:"value"`
+			}{
+				ID:    100,
+				Value: *nullable.NewInt8(120),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an unexpected value",
+			args: args{
+				data: []byte(`{"id": 100, "value":"test"}`),
+			},
+			holder: &struct {
+				ID    int           `json:"id"`
+				Value nullable.Int8 `json:"value"`
+			}{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := json.Unmarshal(tt.args.data, tt.holder)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.holder, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", tt.holder, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt8_Scan(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    nullable.Int8
+		wantErr bool
+	}{
+		{
+			name: "should return a nullable int8 with 0 as value",
+			fields: fields{
+				value: nil,
+			},
+			want: nullable.Int8{
+				Int8:  0,
+				Valid: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable int8 with the given int64 as its value",
+			fields: fields{
+				value: int64(math.MinInt8),
+			},
+			want:    *nullable.NewInt8(math.MinInt8),
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable int8 with the given bytes as its value",
+			fields: fields{
+				value: []byte("-100"),
+			},
+			want:    *nullable.NewInt8(-100),
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable int8 with the given whole float as its value",
+			fields: fields{
+				value: float64(-100),
+			},
+			want:    *nullable.NewInt8(-100),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to a fractional float",
+			fields: fields{
+				value: 1.5,
+			},
+			want:    nullable.Int8{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an overflowed float",
+			fields: fields{
+				value: float64(math.MaxInt8 + 1),
+			},
+			want:    nullable.Int8{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an overflow",
+			fields: fields{
+				value: int64(math.MaxInt8 + 1),
+			},
+			want:    nullable.Int8{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an overflowed string",
+			fields: fields{
+				value: "-129",
+			},
+			want:    nullable.Int8{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an unsupported format",
+			fields: fields{
+				value: make(chan string),
+			},
+			want:    nullable.Int8{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Int8
+			err := n.Scan(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt8_Value(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   driver.Valuer
+		want    driver.Value
+		wantErr bool
+	}{
+		{
+			name:    "should return nil",
+			value:   nullable.Int8{},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "should return the given int8 as int64",
+			value:   *nullable.NewInt8(math.MinInt8),
+			want:    int64(math.MinInt8),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt8_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   nullable.Int8
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "should return an empty text",
+			value:   nullable.Int8{},
+			want:    []byte(""),
+			wantErr: false,
+		},
+		{
+			name:    "should return the given value as text",
+			value:   *nullable.NewInt8(-100),
+			want:    []byte("-100"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt8_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    nullable.Int8
+		wantErr bool
+	}{
+		{
+			name:    "should unmarshal an empty text as null",
+			text:    []byte(""),
+			want:    nullable.Int8{},
+			wantErr: false,
+		},
+		{
+			name:    "should unmarshal the given text",
+			text:    []byte("-100"),
+			want:    *nullable.NewInt8(-100),
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			text:    []byte("128"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Int8
+			err := n.UnmarshalText(tt.text)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
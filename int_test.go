@@ -0,0 +1,358 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/diegohordi/nullable"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestInt_MarshalJSON(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should return null",
+			fields: fields{
+				value: nullable.Int{
+					Int:   0,
+					Valid: false,
+				}},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name: "should return the given int",
+			fields: fields{
+				value: *nullable.NewInt(math.MaxInt),
+			},
+			want:    []byte(fmt.Sprintf("%v", math.MaxInt)),
+			wantErr: false,
+		},
+		{
+			name: "should marshal the given int from a struct",
+			fields: fields{
+				value: &struct {
+					ID    int          `json:"id"`
+					Value nullable.Int `json:"value"`
+				}{
+					ID:    100,
+					Value: *nullable.NewInt(100),
+				},
+			},
+			want:    []byte(`{"id":100,"value":100}`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		holder  interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			args: args{
+				data: []byte("null"),
+			},
+			holder: &nullable.Int{},
+			want: &nullable.Int{
+				Int:   0,
+				Valid: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal into a struct",
+			args: args{
+				data: []byte(`{"id": 100, "value":120}`),
+			},
+			holder: &struct {
+				ID    int          `json:"id"`
+				Value nullable.Int `json:"value"`
+			}{},
+			want: &struct {
+				ID    int          `json:"id"`
+				Value nullable.Int `json:"value"`
+			}{
+				ID:    100,
+				Value: *nullable.NewInt(120),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an unexpected value",
+			args: args{
+				data: []byte(`{"id": 100, "value":"test"}`),
+			},
+			holder: &struct {
+				ID    int          `json:"id"`
+				Value nullable.Int `json:"value"`
+			}{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := json.Unmarshal(tt.args.data, tt.holder)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.holder, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", tt.holder, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_Scan(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    nullable.Int
+		wantErr bool
+	}{
+		{
+			name: "should return a nullable int with 0 as value",
+			fields: fields{
+				value: nil,
+			},
+			want: nullable.Int{
+				Int:   0,
+				Valid: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable int with the given int64 as its value",
+			fields: fields{
+				value: int64(-100),
+			},
+			want:    *nullable.NewInt(-100),
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable int with the given string as its value",
+			fields: fields{
+				value: "100",
+			},
+			want:    *nullable.NewInt(100),
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable int with the given whole float as its value",
+			fields: fields{
+				value: float64(1e9),
+			},
+			want:    *nullable.NewInt(1e9),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to a fractional float",
+			fields: fields{
+				value: -0.5,
+			},
+			want:    nullable.Int{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an overflowed float",
+			fields: fields{
+				value: 1e19,
+			},
+			want:    nullable.Int{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an overflow",
+			fields: fields{
+				value: uint64(math.MaxUint64),
+			},
+			want:    nullable.Int{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an invalid string",
+			fields: fields{
+				value: "test",
+			},
+			want:    nullable.Int{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an unsupported format",
+			fields: fields{
+				value: make(chan string),
+			},
+			want:    nullable.Int{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Int
+			err := n.Scan(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_Value(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   driver.Valuer
+		want    driver.Value
+		wantErr bool
+	}{
+		{
+			name:    "should return nil",
+			value:   nullable.Int{},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "should return the given int as int64",
+			value:   *nullable.NewInt(math.MaxInt),
+			want:    int64(math.MaxInt),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   nullable.Int
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "should return an empty text",
+			value:   nullable.Int{},
+			want:    []byte(""),
+			wantErr: false,
+		},
+		{
+			name:    "should return the given value as text",
+			value:   *nullable.NewInt(-100),
+			want:    []byte("-100"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    nullable.Int
+		wantErr bool
+	}{
+		{
+			name:    "should unmarshal an empty text as null",
+			text:    []byte(""),
+			want:    nullable.Int{},
+			wantErr: false,
+		},
+		{
+			name:    "should unmarshal the given text",
+			text:    []byte("-100"),
+			want:    *nullable.NewInt(-100),
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			text:    []byte("test"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Int
+			err := n.UnmarshalText(tt.text)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// IP is a nullable IP address, for Postgres inet columns holding hosts. The
+// host mask of the Postgres output, as in "10.0.0.1/32", is accepted when
+// scanning and decoding, but other masks are rejected, use Prefix for them.
+type IP struct {
+	Addr   netip.Addr
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewIP(v netip.Addr) *IP {
+	return &IP{
+		Addr:  v,
+		Valid: true,
+	}
+}
+
+func IPFrom(v netip.Addr) IP {
+	return *NewIP(v)
+}
+
+func IPFromPtr(v *netip.Addr) IP {
+	if v == nil {
+		return IP{}
+	}
+	return IPFrom(*v)
+}
+
+func (n IP) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n IP) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Addr)
+}
+
+func (n IP) Get() (netip.Addr, bool) {
+	return get(n.Addr, n.valid())
+}
+
+func (n IP) ValueOr(v netip.Addr) netip.Addr {
+	return valueOr(n.Addr, n.valid(), v)
+}
+
+func (n IP) MustGet() netip.Addr {
+	return mustGet(n.Addr, n.valid())
+}
+
+func (n IP) Ptr() *netip.Addr {
+	return ptr(n.Addr, n.valid())
+}
+
+func (n *IP) Scan(value interface{}) error {
+	return scanValue(value, &n.Addr, &n.Valid, n.policy(), toIP)
+}
+
+func (n IP) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return n.Addr.String(), nil
+}
+
+func (n IP) MarshalJSON() ([]byte, error) {
+	return marshalJSON(ipText(n.Addr), n.valid())
+}
+
+func (n *IP) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, (*ipText)(&n.Addr), &n.Valid, n.policy())
+}
+
+func (n IP) MarshalText() ([]byte, error) {
+	return marshalText(ipText(n.Addr), n.valid())
+}
+
+func (n *IP) UnmarshalText(text []byte) error {
+	return unmarshalText(text, (*ipText)(&n.Addr), &n.Valid, n.policy())
+}
+
+func (n IP) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, ipText(n.Addr), n.valid())
+}
+
+func (n *IP) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, (*ipText)(&n.Addr), &n.Valid, n.policy())
+}
+
+func (n IP) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, ipText(n.Addr), n.valid())
+}
+
+func (n *IP) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, (*ipText)(&n.Addr), &n.Valid, n.policy())
+}
+
+type ipText netip.Addr
+
+func (a ipText) IsZero() bool {
+	return !netip.Addr(a).IsValid()
+}
+
+func (a ipText) MarshalText() ([]byte, error) {
+	return netip.Addr(a).MarshalText()
+}
+
+func (a *ipText) UnmarshalText(text []byte) error {
+	v, err := parseIP(string(text))
+	if err != nil {
+		return err
+	}
+	*a = ipText(v)
+	return nil
+}
+
+func toIP(value interface{}) (netip.Addr, error) {
+	switch src := value.(type) {
+	case string:
+		return parseIP(src)
+	case []byte:
+		return parseIP(string(src))
+	}
+	return netip.Addr{}, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type IP", value)
+}
+
+func parseIP(s string) (netip.Addr, error) {
+	if !strings.Contains(s, "/") {
+		a, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Addr{}, fmt.Errorf("nullable: invalid IP address %q", s)
+		}
+		return a, nil
+	}
+	p, err := netip.ParsePrefix(s)
+	if err != nil || !p.IsSingleIP() {
+		return netip.Addr{}, fmt.Errorf("nullable: invalid IP address %q", s)
+	}
+	return p.Addr(), nil
+}
@@ -0,0 +1,179 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+func TestIP_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []byte
+	}{
+		{
+			name:  "should return null",
+			value: nullable.IP{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should return an IPv4 address",
+			value: *nullable.NewIP(netip.MustParseAddr("10.0.0.1")),
+			want:  []byte(`"10.0.0.1"`),
+		},
+		{
+			name:  "should return a canonical IPv6 address",
+			value: *nullable.NewIP(netip.MustParseAddr("2001:DB8:0:0::1")),
+			want:  []byte(`"2001:db8::1"`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIP_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    nullable.IP
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			data: []byte("null"),
+			want: nullable.IP{},
+		},
+		{
+			name: "should unmarshal an address",
+			data: []byte(`"10.0.0.1"`),
+			want: *nullable.NewIP(netip.MustParseAddr("10.0.0.1")),
+		},
+		{
+			name: "should unmarshal an address with a host mask",
+			data: []byte(`"2001:db8::1/128"`),
+			want: *nullable.NewIP(netip.MustParseAddr("2001:db8::1")),
+		},
+		{
+			name:    "should return an error due to a network mask",
+			data:    []byte(`"10.0.0.1/24"`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an empty address",
+			data:    []byte(`""`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.IP
+			err := json.Unmarshal(tt.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestIP_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    nullable.IP
+		wantErr bool
+	}{
+		{
+			name:  "should return a null address",
+			value: nil,
+			want:  nullable.IP{},
+		},
+		{
+			name:  "should scan an address",
+			value: "10.0.0.1",
+			want:  *nullable.NewIP(netip.MustParseAddr("10.0.0.1")),
+		},
+		{
+			name:  "should scan the Postgres host form",
+			value: []byte("10.0.0.1/32"),
+			want:  *nullable.NewIP(netip.MustParseAddr("10.0.0.1")),
+		},
+		{
+			name:    "should return an error due to an invalid address",
+			value:   "10.0.0.256",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unsupported type",
+			value:   int64(1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.IP
+			err := n.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestIP_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.IP
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.IP{},
+			want:  nil,
+		},
+		{
+			name:  "should return the canonical address",
+			value: *nullable.NewIP(netip.MustParseAddr("2001:DB8::0001")),
+			want:  "2001:db8::1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
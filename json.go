@@ -0,0 +1,294 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+)
+
+// JSON is a nullable JSON document decoded into T, for Postgres json and jsonb
+// and MySQL JSON columns. A SQL NULL is scanned as an invalid value, while a
+// JSON null stored in the column is scanned as a valid zero T. It is only sent
+// back to the database as a JSON null when T is a pointer, slice, map or
+// interface, other types send their zero value, such as {"A":0} for a struct.
+//
+// The document is embedded as is when marshaling JSON, so a valid value
+// holding a nil pointer, slice or map is also marshaled as null, and null is
+// always unmarshaled as an invalid value.
+type JSON[T any] struct {
+	V      T
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewJSON[T any](v T) *JSON[T] {
+	return &JSON[T]{
+		V:     v,
+		Valid: true,
+	}
+}
+
+func JSONFrom[T any](v T) JSON[T] {
+	return *NewJSON(v)
+}
+
+func JSONFromPtr[T any](v *T) JSON[T] {
+	if v == nil {
+		return JSON[T]{}
+	}
+	return JSONFrom(*v)
+}
+
+func (n JSON[T]) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n JSON[T]) valid() bool {
+	return n.Valid && !n.policy().nulls(n.V)
+}
+
+func (n JSON[T]) Get() (T, bool) {
+	return get(n.V, n.valid())
+}
+
+func (n JSON[T]) ValueOr(v T) T {
+	return valueOr(n.V, n.valid(), v)
+}
+
+func (n JSON[T]) MustGet() T {
+	return mustGet(n.V, n.valid())
+}
+
+func (n JSON[T]) Ptr() *T {
+	return ptr(n.V, n.valid())
+}
+
+func (n *JSON[T]) Scan(value interface{}) error {
+	return scanValue(value, &n.V, &n.Valid, n.policy(), toJSON[T])
+}
+
+// Value returns the encoded document as a string, which is accepted by json
+// and jsonb columns without a cast.
+func (n JSON[T]) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	data, err := json.Marshal(n.V)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (n JSON[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(jsonCodec[T]{&n.V}, n.valid())
+}
+
+func (n *JSON[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &jsonCodec[T]{&n.V}, &n.Valid, n.policy())
+}
+
+func (n JSON[T]) MarshalText() ([]byte, error) {
+	return marshalText(jsonCodec[T]{&n.V}, n.valid())
+}
+
+func (n *JSON[T]) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &jsonCodec[T]{&n.V}, &n.Valid, n.policy())
+}
+
+func (n JSON[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, jsonCodec[T]{&n.V}, n.valid())
+}
+
+func (n *JSON[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &jsonCodec[T]{&n.V}, &n.Valid, n.policy())
+}
+
+func (n JSON[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, jsonCodec[T]{&n.V}, n.valid())
+}
+
+func (n *JSON[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &jsonCodec[T]{&n.V}, &n.Valid, n.policy())
+}
+
+type jsonCodec[T any] struct {
+	v *T
+}
+
+func (c jsonCodec[T]) IsZero() bool {
+	return reflect.ValueOf(c.v).Elem().IsZero()
+}
+
+func (c jsonCodec[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(*c.v)
+}
+
+func (c *jsonCodec[T]) UnmarshalJSON(data []byte) error {
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("nullable: cannot decode %s into %T: %w", data, v, err)
+	}
+	*c.v = v
+	return nil
+}
+
+func (c jsonCodec[T]) MarshalText() ([]byte, error) {
+	return c.MarshalJSON()
+}
+
+func (c *jsonCodec[T]) UnmarshalText(text []byte) error {
+	return c.UnmarshalJSON(text)
+}
+
+// RawJSON is a nullable, undecoded JSON document. Like JSON, a JSON null
+// stored in the column is kept apart from a SQL NULL.
+type RawJSON struct {
+	RawMessage json.RawMessage
+	Valid      bool
+	Policy     NullPolicy
+}
+
+func NewRawJSON(v json.RawMessage) *RawJSON {
+	return &RawJSON{
+		RawMessage: v,
+		Valid:      true,
+	}
+}
+
+func RawJSONFrom(v json.RawMessage) RawJSON {
+	return *NewRawJSON(v)
+}
+
+func RawJSONFromPtr(v *json.RawMessage) RawJSON {
+	if v == nil {
+		return RawJSON{}
+	}
+	return RawJSONFrom(*v)
+}
+
+func (n RawJSON) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n RawJSON) valid() bool {
+	return n.Valid && !n.policy().nulls(rawJSONText(n.RawMessage))
+}
+
+func (n RawJSON) Get() (json.RawMessage, bool) {
+	return get(n.RawMessage, n.valid())
+}
+
+func (n RawJSON) ValueOr(v json.RawMessage) json.RawMessage {
+	return valueOr(n.RawMessage, n.valid(), v)
+}
+
+func (n RawJSON) MustGet() json.RawMessage {
+	return mustGet(n.RawMessage, n.valid())
+}
+
+func (n RawJSON) Ptr() *json.RawMessage {
+	return ptr(n.RawMessage, n.valid())
+}
+
+func (n *RawJSON) Scan(value interface{}) error {
+	return scanValue(value, &n.RawMessage, &n.Valid, n.policy(), toRawJSON)
+}
+
+// Value returns the document as a string, or a JSON null when it is empty.
+func (n RawJSON) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	if len(n.RawMessage) == 0 {
+		return string(jsonNullBytes), nil
+	}
+	return string(n.RawMessage), nil
+}
+
+func (n RawJSON) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.RawMessage, n.valid())
+}
+
+func (n *RawJSON) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.RawMessage, &n.Valid, n.policy())
+}
+
+func (n RawJSON) MarshalText() ([]byte, error) {
+	return marshalText(rawJSONText(n.RawMessage), n.valid())
+}
+
+func (n *RawJSON) UnmarshalText(text []byte) error {
+	return unmarshalText(text, (*rawJSONText)(&n.RawMessage), &n.Valid, n.policy())
+}
+
+func (n RawJSON) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, rawJSONText(n.RawMessage), n.valid())
+}
+
+func (n *RawJSON) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, (*rawJSONText)(&n.RawMessage), &n.Valid, n.policy())
+}
+
+func (n RawJSON) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, rawJSONText(n.RawMessage), n.valid())
+}
+
+func (n *RawJSON) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, (*rawJSONText)(&n.RawMessage), &n.Valid, n.policy())
+}
+
+type rawJSONText json.RawMessage
+
+func (r rawJSONText) IsZero() bool {
+	return len(r) == 0
+}
+
+func (r rawJSONText) MarshalText() ([]byte, error) {
+	if len(r) == 0 {
+		return jsonNullBytes, nil
+	}
+	return r, nil
+}
+
+func (r *rawJSONText) UnmarshalText(text []byte) error {
+	raw, err := toRawJSON(text)
+	if err != nil {
+		return err
+	}
+	*r = rawJSONText(raw)
+	return nil
+}
+
+func toJSON[T any](value interface{}) (T, error) {
+	var v T
+	data, err := jsonSource(value)
+	if err != nil {
+		return v, err
+	}
+	err = (&jsonCodec[T]{&v}).UnmarshalJSON(data)
+	return v, err
+}
+
+func toRawJSON(value interface{}) (json.RawMessage, error) {
+	data, err := jsonSource(value)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("nullable: invalid JSON document %q", data)
+	}
+	return append(json.RawMessage{}, data...), nil
+}
+
+func jsonSource(value interface{}) ([]byte, error) {
+	switch src := value.(type) {
+	case []byte:
+		return src, nil
+	case string:
+		return []byte(src), nil
+	}
+	return nil, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into a JSON document", value)
+}
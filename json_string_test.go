@@ -0,0 +1,208 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestJSONString_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []byte
+	}{
+		{
+			name:  "should return null",
+			value: nullable.Int64String{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should return a quoted int64",
+			value: *nullable.NewInt64String(math.MaxInt64),
+			want:  []byte(`"9223372036854775807"`),
+		},
+		{
+			name:  "should return a quoted uint64",
+			value: *nullable.NewUint64String(math.MaxUint64),
+			want:  []byte(`"18446744073709551615"`),
+		},
+		{
+			name:  "should return a quoted decimal",
+			value: *nullable.NewDecimalString(big.NewRat(1, 8)),
+			want:  []byte(`"0.125"`),
+		},
+		{
+			name: "should marshal the given value from a struct",
+			value: &struct {
+				ID    nullable.Int64String `json:"id"`
+				Value nullable.Int64       `json:"value"`
+			}{
+				ID:    *nullable.NewInt64String(1465320195841232897),
+				Value: *nullable.NewInt64(100),
+			},
+			want: []byte(`{"id":"1465320195841232897","value":100}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONString_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		dest    json.Unmarshaler
+		data    []byte
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			dest: &nullable.Int64String{},
+			data: []byte("null"),
+			want: &nullable.Int64String{},
+		},
+		{
+			name: "should unmarshal a quoted int64",
+			dest: &nullable.Int64String{},
+			data: []byte(`"9223372036854775807"`),
+			want: nullable.NewInt64String(math.MaxInt64),
+		},
+		{
+			name: "should unmarshal an int64 number",
+			dest: &nullable.Int64String{},
+			data: []byte(`-100`),
+			want: nullable.NewInt64String(-100),
+		},
+		{
+			name: "should unmarshal a quoted uint64",
+			dest: &nullable.Uint64String{},
+			data: []byte(`"18446744073709551615"`),
+			want: nullable.NewUint64String(math.MaxUint64),
+		},
+		{
+			name: "should unmarshal a quoted decimal",
+			dest: &nullable.DecimalString{},
+			data: []byte(`"0.125"`),
+			want: nullable.NewDecimalString(big.NewRat(1, 8)),
+		},
+		{
+			name: "should unmarshal a decimal number",
+			dest: &nullable.DecimalString{},
+			data: []byte(`0.125`),
+			want: nullable.NewDecimalString(big.NewRat(1, 8)),
+		},
+		{
+			name:    "should return an error due to an invalid int64",
+			dest:    &nullable.Int64String{},
+			data:    []byte(`"1.5"`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an out of range int64",
+			dest:    &nullable.Int64String{},
+			data:    []byte(`"9223372036854775808"`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to a quoted null",
+			dest:    &nullable.Int64String{},
+			data:    []byte(`"null"`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to a quoted null uint64",
+			dest:    &nullable.Uint64String{},
+			data:    []byte(`"null"`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an empty string",
+			dest:    &nullable.Int64String{},
+			data:    []byte(`""`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an empty decimal string",
+			dest:    &nullable.DecimalString{},
+			data:    []byte(`""`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to a negative uint64",
+			dest:    &nullable.Uint64String{},
+			data:    []byte(`"-1"`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.dest.UnmarshalJSON(tt.data)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(tt.dest, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", tt.dest, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONString_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value driver.Valuer
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.Int64String{},
+			want:  nil,
+		},
+		{
+			name:  "should return an int64",
+			value: *nullable.NewInt64String(math.MaxInt64),
+			want:  int64(math.MaxInt64),
+		},
+		{
+			name:  "should return the uint64 fallback",
+			value: *nullable.NewUint64String(math.MaxUint64),
+			want:  "18446744073709551615",
+		},
+		{
+			name:  "should return a decimal string",
+			value: *nullable.NewDecimalString(big.NewRat(1, 8)),
+			want:  "0.125",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
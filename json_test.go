@@ -0,0 +1,303 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+)
+
+type payload struct {
+	Name string `json:"name"`
+}
+
+func TestJSON_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []byte
+	}{
+		{
+			name:  "should return null",
+			value: nullable.JSON[payload]{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should embed the document",
+			value: *nullable.NewJSON(payload{Name: "nullable"}),
+			want:  []byte(`{"name":"nullable"}`),
+		},
+		{
+			name: "should embed the document in a struct",
+			value: &struct {
+				ID    int                      `json:"id"`
+				Value nullable.JSON[[]payload] `json:"value"`
+			}{
+				ID:    100,
+				Value: *nullable.NewJSON([]payload{{Name: "nullable"}}),
+			},
+			want: []byte(`{"id":100,"value":[{"name":"nullable"}]}`),
+		},
+		{
+			name:  "should embed the raw document",
+			value: *nullable.NewRawJSON(json.RawMessage(`{"name": "nullable"}`)),
+			want:  []byte(`{"name":"nullable"}`),
+		},
+		{
+			name:  "should return null for a null raw document",
+			value: nullable.RawJSON{},
+			want:  []byte("null"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSON_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    nullable.JSON[payload]
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			data: []byte("null"),
+			want: nullable.JSON[payload]{},
+		},
+		{
+			name: "should unmarshal the document",
+			data: []byte(`{"name":"nullable"}`),
+			want: *nullable.NewJSON(payload{Name: "nullable"}),
+		},
+		{
+			name:    "should return an error due to an unexpected document",
+			data:    []byte(`["nullable"]`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.JSON[payload]
+			err := json.Unmarshal(tt.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSON_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    nullable.JSON[*payload]
+		wantErr bool
+	}{
+		{
+			name:  "should return a null document",
+			value: nil,
+			want:  nullable.JSON[*payload]{},
+		},
+		{
+			name:  "should scan a JSON null as a valid value",
+			value: []byte("null"),
+			want:  *nullable.NewJSON[*payload](nil),
+		},
+		{
+			name:  "should scan the document from bytes",
+			value: []byte(`{"name":"nullable"}`),
+			want:  *nullable.NewJSON(&payload{Name: "nullable"}),
+		},
+		{
+			name:  "should scan the document from a string",
+			value: `{"name":"nullable"}`,
+			want:  *nullable.NewJSON(&payload{Name: "nullable"}),
+		},
+		{
+			name:    "should return an error due to an invalid document",
+			value:   []byte(`{"name":`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unsupported type",
+			value:   int64(1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.JSON[*payload]
+			err := n.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSON_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value driver.Valuer
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.JSON[payload]{},
+			want:  nil,
+		},
+		{
+			name:  "should return the document",
+			value: *nullable.NewJSON(payload{Name: "nullable"}),
+			want:  `{"name":"nullable"}`,
+		},
+		{
+			name:  "should return a JSON null",
+			value: *nullable.NewJSON[map[string]int](nil),
+			want:  "null",
+		},
+		{
+			name:  "should return nil for a null raw document",
+			value: nullable.RawJSON{},
+			want:  nil,
+		},
+		{
+			name:  "should return the raw document",
+			value: *nullable.NewRawJSON(json.RawMessage(`{"name": "nullable"}`)),
+			want:  `{"name": "nullable"}`,
+		},
+		{
+			name:  "should return a JSON null for an empty raw document",
+			value: *nullable.NewRawJSON(nil),
+			want:  "null",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSON_ScanNullValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface {
+			sql.Scanner
+			driver.Valuer
+		}
+		want driver.Value
+	}{
+		{
+			name:  "should write back a JSON null for a pointer",
+			value: &nullable.JSON[*payload]{},
+			want:  "null",
+		},
+		{
+			name:  "should write back a JSON null for a map",
+			value: &nullable.JSON[map[string]int]{},
+			want:  "null",
+		},
+		{
+			name:  "should write back the zero document for a struct",
+			value: &nullable.JSON[payload]{},
+			want:  `{"name":""}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.value.Scan([]byte("null")); err != nil {
+				t.Errorf("Scan() error = %v", err)
+				return
+			}
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRawJSON_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    nullable.RawJSON
+		wantErr bool
+	}{
+		{
+			name:  "should return a null document",
+			value: nil,
+			want:  nullable.RawJSON{},
+		},
+		{
+			name:  "should scan a JSON null as a valid value",
+			value: []byte("null"),
+			want:  *nullable.NewRawJSON(json.RawMessage("null")),
+		},
+		{
+			name:  "should scan the document",
+			value: `[1, 2]`,
+			want:  *nullable.NewRawJSON(json.RawMessage(`[1, 2]`)),
+		},
+		{
+			name:    "should return an error due to an invalid document",
+			value:   []byte(`[1, 2`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.RawJSON
+			err := n.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
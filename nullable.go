@@ -1,6 +1,96 @@
 package nullable
 
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
 var (
 	jsonNullBytes  = []byte("null")
 	jsonEmptyBytes = []byte(`""`)
 )
+
+func marshalJSON[T any](v T, valid bool) ([]byte, error) {
+	if !valid {
+		return jsonNullBytes, nil
+	}
+	return json.Marshal(v)
+}
+
+func unmarshalJSON[T any](data []byte, v *T, valid *bool, p NullPolicy) error {
+	if bytes.Equal(data, jsonNullBytes) || (p >= EmptyAsNull && bytes.Equal(data, jsonEmptyBytes)) {
+		*valid = false
+		return nil
+	}
+	err := decodeJSON(data, v)
+	if err != nil {
+		return err
+	}
+	*valid = !p.nulls(*v)
+	return nil
+}
+
+var ErrNull = errors.New("nullable: value is null")
+
+func get[T any](v T, valid bool) (T, bool) {
+	if !valid {
+		var zero T
+		return zero, false
+	}
+	return v, true
+}
+
+func valueOr[T any](v T, valid bool, def T) T {
+	if !valid {
+		return def
+	}
+	return v
+}
+
+func mustGet[T any](v T, valid bool) T {
+	if !valid {
+		panic(ErrNull)
+	}
+	return v
+}
+
+func ptr[T any](v T, valid bool) *T {
+	if !valid {
+		return nil
+	}
+	return &v
+}
+
+// jsonString encodes *v, marshaled by encoding/json as a number, as a JSON
+// string, and decodes both JSON strings and numbers into it.
+type jsonString[T any] struct {
+	v *T
+}
+
+func (s jsonString[T]) IsZero() bool {
+	return ZeroAsNull.nulls(*s.v)
+}
+
+func (s jsonString[T]) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(*s.v)
+	if err != nil || (len(data) > 0 && data[0] == '"') {
+		return data, err
+	}
+	return json.Marshal(string(data))
+}
+
+func (s *jsonString[T]) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		if !numberRegexp.MatchString(str) {
+			return fmt.Errorf("nullable: cannot decode %s into %T: not a number", data, *s.v)
+		}
+		data = []byte(str)
+	}
+	return decodeJSON(data, s.v)
+}
@@ -0,0 +1,100 @@
+package nullable
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+// Of wraps any type T, adding the same JSON and database behavior provided by
+// the other types of this package, so domain types can be made nullable
+// without writing their own wrapper.
+type Of[T any] struct {
+	sql.Null[T]
+	Policy NullPolicy
+}
+
+func New[T any](v T) *Of[T] {
+	return &Of[T]{Null: sql.Null[T]{
+		V:     v,
+		Valid: true,
+	}}
+}
+
+func From[T any](v T) Of[T] {
+	return *New(v)
+}
+
+func FromPtr[T any](v *T) Of[T] {
+	if v == nil {
+		return Of[T]{}
+	}
+	return From(*v)
+}
+
+func (n Of[T]) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Of[T]) valid() bool {
+	return n.Valid && !n.policy().nulls(n.V)
+}
+
+func (n Of[T]) Get() (T, bool) {
+	return get(n.V, n.valid())
+}
+
+func (n Of[T]) ValueOr(v T) T {
+	return valueOr(n.V, n.valid(), v)
+}
+
+func (n Of[T]) MustGet() T {
+	return mustGet(n.V, n.valid())
+}
+
+func (n Of[T]) Ptr() *T {
+	return ptr(n.V, n.valid())
+}
+
+func (n *Of[T]) Scan(value interface{}) error {
+	return scanValue(value, &n.V, &n.Valid, n.policy(), convertAssign[T])
+}
+
+func (n Of[T]) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
+func (n Of[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.V, n.valid())
+}
+
+func (n *Of[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.V, &n.Valid, n.policy())
+}
+
+func (n Of[T]) MarshalText() ([]byte, error) {
+	return marshalText(n.V, n.valid())
+}
+
+func (n *Of[T]) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.V, &n.Valid, n.policy())
+}
+
+func (n Of[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.V, n.valid())
+}
+
+func (n *Of[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.V, &n.Valid, n.policy())
+}
+
+func (n Of[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.V, n.valid())
+}
+
+func (n *Of[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.V, &n.Valid, n.policy())
+}
@@ -0,0 +1,338 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+)
+
+type status string
+
+func TestOf_MarshalJSON(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should return null",
+			fields: fields{
+				value: nullable.Of[status]{},
+			},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name: "should return the given value",
+			fields: fields{
+				value: *nullable.New(status("active")),
+			},
+			want:    []byte(`"active"`),
+			wantErr: false,
+		},
+		{
+			name: "should marshal the given value from a struct",
+			fields: fields{
+				value: &struct {
+					ID    int                 `json:"id"`
+					Value nullable.Of[status] `json:"value"`
+				}{
+					ID:    100,
+					Value: *nullable.New(status("active")),
+				},
+			},
+			want:    []byte(`{"id":100,"value":"active"}`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOf_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		holder  interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			args: args{
+				data: []byte("null"),
+			},
+			holder:  &nullable.Of[status]{},
+			want:    &nullable.Of[status]{},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal into a struct",
+			args: args{
+				data: []byte(`{"id": 100, "value":"active"}`),
+			},
+			holder: &struct {
+				ID    int                 `json:"id"`
+				Value nullable.Of[status] `json:"value"`
+			}{},
+			want: &struct {
+				ID    int                 `json:"id"`
+				Value nullable.Of[status] `json:"value"`
+			}{
+				ID:    100,
+				Value: *nullable.New(status("active")),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an unexpected value",
+			args: args{
+				data: []byte(`{"id": 100, "value":1}`),
+			},
+			holder: &struct {
+				ID    int                 `json:"id"`
+				Value nullable.Of[status] `json:"value"`
+			}{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := json.Unmarshal(tt.args.data, tt.holder)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.holder, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", tt.holder, tt.want)
+			}
+		})
+	}
+}
+
+func TestOf_Scan(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    nullable.Of[status]
+		wantErr bool
+	}{
+		{
+			name: "should return a nullable value with the zero value as value",
+			fields: fields{
+				value: nil,
+			},
+			want: nullable.Of[status]{Null: sql.Null[status]{
+				V:     "",
+				Valid: false,
+			}},
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable value with the given value as its value",
+			fields: fields{
+				value: []byte("active"),
+			},
+			want:    *nullable.New(status("active")),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an unsupported format",
+			fields: fields{
+				value: make(chan string),
+			},
+			want:    nullable.Of[status]{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Of[status]
+			err := n.Scan(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestOf_Value(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   driver.Valuer
+		want    driver.Value
+		wantErr bool
+	}{
+		{
+			name:    "should return nil",
+			value:   nullable.Of[status]{},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "should return the underlying kind of the given value",
+			value:   *nullable.New(status("active")),
+			want:    "active",
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to an unsupported type",
+			value:   *nullable.New(make(chan string)),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOf_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   nullable.Of[status]
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "should return an empty text",
+			value:   nullable.Of[status]{},
+			want:    []byte(""),
+			wantErr: false,
+		},
+		{
+			name:    "should return the given value as text",
+			value:   *nullable.New(status("active")),
+			want:    []byte("active"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOf_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    nullable.Of[status]
+		wantErr bool
+	}{
+		{
+			name:    "should unmarshal an empty text as null",
+			text:    []byte(""),
+			want:    nullable.Of[status]{},
+			wantErr: false,
+		},
+		{
+			name:    "should unmarshal the given text",
+			text:    []byte("active"),
+			want:    *nullable.New(status("active")),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Of[status]
+			err := n.UnmarshalText(tt.text)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+type priority int
+
+func (p *priority) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case `"low"`:
+		*p = 1
+	case `"high"`:
+		*p = 2
+	default:
+		return fmt.Errorf("invalid priority %s", data)
+	}
+	return nil
+}
+
+func TestOf_UnmarshalJSON_DecodeModes(t *testing.T) {
+	for _, mode := range []nullable.DecodeMode{nullable.StandardDecoding, nullable.LenientDecoding, nullable.StrictDecoding} {
+		t.Run(fmt.Sprintf("mode %d", mode), func(t *testing.T) {
+			defer func(mode nullable.DecodeMode) { nullable.JSONDecodeMode = mode }(nullable.JSONDecodeMode)
+			nullable.JSONDecodeMode = mode
+			var n nullable.Of[priority]
+			if err := json.Unmarshal([]byte(`"high"`), &n); err != nil {
+				t.Errorf("UnmarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(n, *nullable.New(priority(2))) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, *nullable.New(priority(2)))
+			}
+		})
+	}
+}
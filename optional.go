@@ -0,0 +1,162 @@
+package nullable
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"time"
+)
+
+// optionalValue is a nullable type of this package holding values of T.
+type optionalValue[T any] interface {
+	driver.Valuer
+	json.Marshaler
+	encoding.TextMarshaler
+	xml.Marshaler
+	xml.MarshalerAttr
+	Get() (T, bool)
+	ValueOr(T) T
+	MustGet() T
+	Ptr() *T
+}
+
+type optionalPointer[N any] interface {
+	*N
+	sql.Scanner
+	json.Unmarshaler
+	encoding.TextUnmarshaler
+	xml.Unmarshaler
+	xml.UnmarshalerAttr
+}
+
+// Optional adds to a nullable N a Set flag, telling whether the value was
+// present at all. This makes possible to distinguish an absent JSON field (Set
+// is false) from an explicit null (Set is true and the value is null). Unset
+// fields are omitted when marshaling XML, as well as JSON when using the
+// omitzero tag option.
+type Optional[T any, N optionalValue[T], P optionalPointer[N]] struct {
+	Nullable N
+	Set      bool
+}
+
+// The Optional counterparts of Of and of the sql package based types.
+type (
+	OptionalOf[T any] = Optional[T, Of[T], *Of[T]]
+	OptionalBool      = Optional[bool, Bool, *Bool]
+	OptionalInt16     = Optional[int16, Int16, *Int16]
+	OptionalInt32     = Optional[int32, Int32, *Int32]
+	OptionalInt64     = Optional[int64, Int64, *Int64]
+	OptionalFloat64   = Optional[float64, Float64, *Float64]
+	OptionalString    = Optional[string, String, *String]
+	OptionalTime      = Optional[time.Time, Time, *Time]
+)
+
+func NewOptional[T any](v T) *OptionalOf[T] {
+	return &OptionalOf[T]{Nullable: *New(v), Set: true}
+}
+
+func NewOptionalBool(v bool) *OptionalBool {
+	return &OptionalBool{Nullable: *NewBool(v), Set: true}
+}
+
+func NewOptionalInt16(v int16) *OptionalInt16 {
+	return &OptionalInt16{Nullable: *NewInt16(v), Set: true}
+}
+
+func NewOptionalInt32(v int32) *OptionalInt32 {
+	return &OptionalInt32{Nullable: *NewInt32(v), Set: true}
+}
+
+func NewOptionalInt64(v int64) *OptionalInt64 {
+	return &OptionalInt64{Nullable: *NewInt64(v), Set: true}
+}
+
+func NewOptionalFloat64(v float64) *OptionalFloat64 {
+	return &OptionalFloat64{Nullable: *NewFloat64(v), Set: true}
+}
+
+func NewOptionalString(v string) *OptionalString {
+	return &OptionalString{Nullable: *NewString(v), Set: true}
+}
+
+func NewOptionalTime(v time.Time) *OptionalTime {
+	return &OptionalTime{Nullable: *NewTime(v), Set: true}
+}
+
+func (o Optional[T, N, P]) IsZero() bool {
+	return !o.Set
+}
+
+func (o Optional[T, N, P]) IsNull() bool {
+	_, valid := o.Nullable.Get()
+	return o.Set && !valid
+}
+
+func (o Optional[T, N, P]) Get() (T, bool) {
+	return o.Nullable.Get()
+}
+
+func (o Optional[T, N, P]) ValueOr(v T) T {
+	return o.Nullable.ValueOr(v)
+}
+
+func (o Optional[T, N, P]) MustGet() T {
+	return o.Nullable.MustGet()
+}
+
+func (o Optional[T, N, P]) Ptr() *T {
+	return o.Nullable.Ptr()
+}
+
+func (o *Optional[T, N, P]) Scan(value interface{}) error {
+	o.Set = true
+	return P(&o.Nullable).Scan(value)
+}
+
+func (o Optional[T, N, P]) Value() (driver.Value, error) {
+	return o.Nullable.Value()
+}
+
+func (o Optional[T, N, P]) MarshalJSON() ([]byte, error) {
+	return o.Nullable.MarshalJSON()
+}
+
+func (o *Optional[T, N, P]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	return P(&o.Nullable).UnmarshalJSON(data)
+}
+
+func (o Optional[T, N, P]) MarshalText() ([]byte, error) {
+	return o.Nullable.MarshalText()
+}
+
+func (o *Optional[T, N, P]) UnmarshalText(text []byte) error {
+	o.Set = true
+	return P(&o.Nullable).UnmarshalText(text)
+}
+
+func (o Optional[T, N, P]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.Set {
+		return nil
+	}
+	return o.Nullable.MarshalXML(e, start)
+}
+
+func (o *Optional[T, N, P]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.Set = true
+	return P(&o.Nullable).UnmarshalXML(d, start)
+}
+
+func (o Optional[T, N, P]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.Set {
+		return xml.Attr{}, nil
+	}
+	return o.Nullable.MarshalXMLAttr(name)
+}
+
+func (o *Optional[T, N, P]) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.Set = true
+	return P(&o.Nullable).UnmarshalXMLAttr(attr)
+}
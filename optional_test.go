@@ -0,0 +1,511 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+)
+
+type patch struct {
+	Name     nullable.OptionalString  `json:"name,omitzero"`
+	Age      nullable.OptionalInt16   `json:"age,omitzero"`
+	Active   nullable.OptionalBool    `json:"active,omitzero"`
+	Born     nullable.OptionalTime    `json:"born,omitzero"`
+	Score    nullable.OptionalFloat64 `json:"score,omitzero"`
+	Visits   nullable.OptionalInt32   `json:"visits,omitzero"`
+	Balance  nullable.OptionalInt64   `json:"balance,omitzero"`
+	Category nullable.OptionalOf[int] `json:"category,omitzero"`
+}
+
+type xmlPatch struct {
+	XMLName  xml.Name                 `xml:"patch"`
+	Name     nullable.OptionalString  `xml:"name"`
+	Age      nullable.OptionalInt16   `xml:"age"`
+	Active   nullable.OptionalBool    `xml:"active"`
+	Born     nullable.OptionalTime    `xml:"born"`
+	Score    nullable.OptionalFloat64 `xml:"score"`
+	Visits   nullable.OptionalInt32   `xml:"visits"`
+	Balance  nullable.OptionalInt64   `xml:"balance"`
+	Category nullable.OptionalOf[int] `xml:"category"`
+}
+
+type xmlPatchAttrs struct {
+	XMLName  xml.Name                 `xml:"patch"`
+	Name     nullable.OptionalString  `xml:"name,attr"`
+	Age      nullable.OptionalInt16   `xml:"age,attr"`
+	Active   nullable.OptionalBool    `xml:"active,attr"`
+	Born     nullable.OptionalTime    `xml:"born,attr"`
+	Score    nullable.OptionalFloat64 `xml:"score,attr"`
+	Visits   nullable.OptionalInt32   `xml:"visits,attr"`
+	Balance  nullable.OptionalInt64   `xml:"balance,attr"`
+	Category nullable.OptionalOf[int] `xml:"category,attr"`
+}
+
+func TestOptional_MarshalJSON(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should omit unset fields",
+			fields: fields{
+				value: patch{},
+			},
+			want:    []byte(`{}`),
+			wantErr: false,
+		},
+		{
+			name: "should return null for set but null fields",
+			fields: fields{
+				value: patch{
+					Name: nullable.OptionalString{Set: true},
+					Born: nullable.OptionalTime{Set: true},
+				},
+			},
+			want:    []byte(`{"name":null,"born":null}`),
+			wantErr: false,
+		},
+		{
+			name: "should return the given values",
+			fields: fields{
+				value: patch{
+					Name:     *nullable.NewOptionalString("test"),
+					Age:      *nullable.NewOptionalInt16(30),
+					Active:   *nullable.NewOptionalBool(true),
+					Born:     *nullable.NewOptionalTime(timeRef),
+					Score:    *nullable.NewOptionalFloat64(1.5),
+					Visits:   *nullable.NewOptionalInt32(10),
+					Balance:  *nullable.NewOptionalInt64(100),
+					Category: *nullable.NewOptional(1),
+				},
+			},
+			want: []byte(fmt.Sprintf(
+				`{"name":"test","age":30,"active":true,"born":"%s","score":1.5,"visits":10,"balance":100,"category":1}`,
+				timeRefStr,
+			)),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptional_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    patch
+		wantErr bool
+	}{
+		{
+			name: "should leave absent fields unset",
+			args: args{
+				data: []byte(`{}`),
+			},
+			want:    patch{},
+			wantErr: false,
+		},
+		{
+			name: "should set null fields",
+			args: args{
+				data: []byte(`{"name":null,"born":""}`),
+			},
+			want: patch{
+				Name: nullable.OptionalString{Set: true},
+				Born: nullable.OptionalTime{Set: true},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should set the given values",
+			args: args{
+				data: []byte(fmt.Sprintf(
+					`{"name":"test","age":30,"active":true,"born":"%s","score":1.5,"visits":10,"balance":100,"category":1}`,
+					timeRefStr,
+				)),
+			},
+			want: patch{
+				Name:     *nullable.NewOptionalString("test"),
+				Age:      *nullable.NewOptionalInt16(30),
+				Active:   *nullable.NewOptionalBool(true),
+				Born:     *nullable.NewOptionalTime(timeRef),
+				Score:    *nullable.NewOptionalFloat64(1.5),
+				Visits:   *nullable.NewOptionalInt32(10),
+				Balance:  *nullable.NewOptionalInt64(100),
+				Category: *nullable.NewOptional(1),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an unexpected value",
+			args: args{
+				data: []byte(`{"age":"test"}`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got patch
+			err := json.Unmarshal(tt.args.data, &got)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptional_Uint8(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		wantSet  bool
+		wantNull bool
+		want     uint8
+	}{
+		{
+			name:     "should set a null value",
+			data:     []byte(`{"level":null}`),
+			wantSet:  true,
+			wantNull: true,
+		},
+		{
+			name:    "should set the given value",
+			data:    []byte(`{"level":3}`),
+			wantSet: true,
+			want:    3,
+		},
+		{
+			name: "should keep an absent value unset",
+			data: []byte(`{}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got struct {
+				Level nullable.Optional[uint8, nullable.Uint8, *nullable.Uint8] `json:"level"`
+			}
+			if err := json.Unmarshal(tt.data, &got); err != nil {
+				t.Errorf("UnmarshalJSON() error = %v", err)
+				return
+			}
+			if got.Level.Set != tt.wantSet || got.Level.IsNull() != tt.wantNull || got.Level.ValueOr(0) != tt.want {
+				t.Errorf("UnmarshalJSON() got = %+v", got.Level)
+			}
+		})
+	}
+}
+
+func TestOptional_IsNull(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.OptionalString
+		want  bool
+	}{
+		{
+			name:  "should not be null when unset",
+			value: nullable.OptionalString{},
+			want:  false,
+		},
+		{
+			name:  "should be null when set without a valid value",
+			value: nullable.OptionalString{Set: true},
+			want:  true,
+		},
+		{
+			name:  "should not be null when set with a valid value",
+			value: *nullable.NewOptionalString(""),
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.IsNull(); got != tt.want {
+				t.Errorf("IsNull() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptional_Scan(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    nullable.OptionalInt64
+		wantErr bool
+	}{
+		{
+			name: "should return a set null value",
+			fields: fields{
+				value: nil,
+			},
+			want:    nullable.OptionalInt64{Set: true},
+			wantErr: false,
+		},
+		{
+			name: "should return a set value with the given value as its value",
+			fields: fields{
+				value: int64(100),
+			},
+			want:    *nullable.NewOptionalInt64(100),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an unsupported format",
+			fields: fields{
+				value: make(chan string),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.OptionalInt64
+			err := n.Scan(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptional_MarshalXML(t *testing.T) {
+	const nilAttrs = `xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"`
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{
+			name:  "should omit unset elements",
+			value: xmlPatch{},
+			want:  `<patch></patch>`,
+		},
+		{
+			name: "should return nil elements for set null values",
+			value: xmlPatch{
+				Name:     nullable.OptionalString{Set: true},
+				Age:      nullable.OptionalInt16{Set: true},
+				Active:   nullable.OptionalBool{Set: true},
+				Born:     nullable.OptionalTime{Set: true},
+				Score:    nullable.OptionalFloat64{Set: true},
+				Visits:   nullable.OptionalInt32{Set: true},
+				Balance:  nullable.OptionalInt64{Set: true},
+				Category: nullable.OptionalOf[int]{Set: true},
+			},
+			want: fmt.Sprintf(
+				`<patch><name %[1]s></name><age %[1]s></age><active %[1]s></active><born %[1]s></born>`+
+					`<score %[1]s></score><visits %[1]s></visits><balance %[1]s></balance>`+
+					`<category %[1]s></category></patch>`,
+				nilAttrs,
+			),
+		},
+		{
+			name: "should return the given values as elements",
+			value: xmlPatch{
+				Name:     *nullable.NewOptionalString("test"),
+				Age:      *nullable.NewOptionalInt16(30),
+				Active:   *nullable.NewOptionalBool(true),
+				Born:     *nullable.NewOptionalTime(timeRef),
+				Score:    *nullable.NewOptionalFloat64(1.5),
+				Visits:   *nullable.NewOptionalInt32(10),
+				Balance:  *nullable.NewOptionalInt64(100),
+				Category: *nullable.NewOptional(2),
+			},
+			want: fmt.Sprintf(
+				`<patch><name>test</name><age>30</age><active>true</active><born>%s</born><score>1.5</score>`+
+					`<visits>10</visits><balance>100</balance><category>2</category></patch>`,
+				timeRefStr,
+			),
+		},
+		{
+			name: "should omit unset and null attributes",
+			value: xmlPatchAttrs{
+				Name: nullable.OptionalString{Set: true},
+				Age:  nullable.OptionalInt16{Set: true},
+			},
+			want: `<patch></patch>`,
+		},
+		{
+			name: "should return the given values as attributes",
+			value: xmlPatchAttrs{
+				Name:     *nullable.NewOptionalString("test"),
+				Age:      *nullable.NewOptionalInt16(30),
+				Active:   *nullable.NewOptionalBool(true),
+				Born:     *nullable.NewOptionalTime(timeRef),
+				Score:    *nullable.NewOptionalFloat64(1.5),
+				Visits:   *nullable.NewOptionalInt32(10),
+				Balance:  *nullable.NewOptionalInt64(100),
+				Category: *nullable.NewOptional(2),
+			},
+			want: fmt.Sprintf(
+				`<patch name="test" age="30" active="true" born="%s" score="1.5" visits="10" balance="100" `+
+					`category="2"></patch>`,
+				timeRefStr,
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xml.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalXML() error = %v", err)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalXML() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptional_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "should keep absent elements unset",
+			data: `<patch></patch>`,
+			want: xmlPatch{XMLName: xml.Name{Local: "patch"}},
+		},
+		{
+			name: "should set nil and empty elements as null",
+			data: `<patch xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><name xsi:nil="true"/><age/>` +
+				`<active/><born/><score/><visits/><balance/><category/></patch>`,
+			want: xmlPatch{
+				XMLName:  xml.Name{Local: "patch"},
+				Name:     nullable.OptionalString{Set: true},
+				Age:      nullable.OptionalInt16{Set: true},
+				Active:   nullable.OptionalBool{Set: true},
+				Born:     nullable.OptionalTime{Set: true},
+				Score:    nullable.OptionalFloat64{Set: true},
+				Visits:   nullable.OptionalInt32{Set: true},
+				Balance:  nullable.OptionalInt64{Set: true},
+				Category: nullable.OptionalOf[int]{Set: true},
+			},
+		},
+		{
+			name: "should set the given elements",
+			data: fmt.Sprintf(
+				`<patch><name>test</name><age>30</age><active>true</active><born>%s</born><score>1.5</score>`+
+					`<visits>10</visits><balance>100</balance><category>2</category></patch>`,
+				timeRefStr,
+			),
+			want: xmlPatch{
+				XMLName:  xml.Name{Local: "patch"},
+				Name:     *nullable.NewOptionalString("test"),
+				Age:      *nullable.NewOptionalInt16(30),
+				Active:   *nullable.NewOptionalBool(true),
+				Born:     *nullable.NewOptionalTime(timeRef),
+				Score:    *nullable.NewOptionalFloat64(1.5),
+				Visits:   *nullable.NewOptionalInt32(10),
+				Balance:  *nullable.NewOptionalInt64(100),
+				Category: *nullable.NewOptional(2),
+			},
+		},
+		{
+			name: "should set empty attributes as null",
+			data: `<patch age="" active="" born="" score="" visits="" balance="" category=""></patch>`,
+			want: xmlPatchAttrs{
+				XMLName:  xml.Name{Local: "patch"},
+				Age:      nullable.OptionalInt16{Set: true},
+				Active:   nullable.OptionalBool{Set: true},
+				Born:     nullable.OptionalTime{Set: true},
+				Score:    nullable.OptionalFloat64{Set: true},
+				Visits:   nullable.OptionalInt32{Set: true},
+				Balance:  nullable.OptionalInt64{Set: true},
+				Category: nullable.OptionalOf[int]{Set: true},
+			},
+		},
+		{
+			name: "should set the given attributes",
+			data: fmt.Sprintf(
+				`<patch name="test" age="30" active="true" born="%s" score="1.5" visits="10" balance="100" `+
+					`category="2"></patch>`,
+				timeRefStr,
+			),
+			want: xmlPatchAttrs{
+				XMLName:  xml.Name{Local: "patch"},
+				Name:     *nullable.NewOptionalString("test"),
+				Age:      *nullable.NewOptionalInt16(30),
+				Active:   *nullable.NewOptionalBool(true),
+				Born:     *nullable.NewOptionalTime(timeRef),
+				Score:    *nullable.NewOptionalFloat64(1.5),
+				Visits:   *nullable.NewOptionalInt32(10),
+				Balance:  *nullable.NewOptionalInt64(100),
+				Category: *nullable.NewOptional(2),
+			},
+		},
+		{
+			name:    "should return an error due to an unexpected element value",
+			data:    `<patch><age>test</age></patch>`,
+			want:    xmlPatch{},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unexpected attribute value",
+			data:    `<patch age="test"></patch>`,
+			want:    xmlPatchAttrs{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reflect.New(reflect.TypeOf(tt.want))
+			err := xml.Unmarshal([]byte(tt.data), got.Interface())
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalXML() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got.Elem().Interface(), tt.want) {
+				t.Errorf("UnmarshalXML() got = %v, want %v", got.Elem().Interface(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package nullable
+
+import "reflect"
+
+// NullPolicy defines which values, besides null, are handled as null when
+// marshaling, unmarshaling, scanning and sending values to the database.
+type NullPolicy uint8
+
+const (
+	// TypeDefault applies the default policy of each type, which is ZeroAsNull
+	// for Time and Date, EmptyAsNull for TimeOfDay and Strict for the others.
+	// Time is Strict when scanning and sending values to the database.
+	TypeDefault NullPolicy = iota
+	// Strict handles only null as null.
+	Strict
+	// EmptyAsNull also handles empty strings, including JSON "", and empty
+	// byte slices as null.
+	EmptyAsNull
+	// ZeroAsNull also handles empty strings and the zero value of each type,
+	// such as 0, false or the zero time, as null.
+	ZeroAsNull
+)
+
+// DefaultNullPolicy is applied to the values without a policy of their own.
+var DefaultNullPolicy = TypeDefault
+
+func (p NullPolicy) resolve(typeDefault NullPolicy) NullPolicy {
+	if p != TypeDefault {
+		return p
+	}
+	if DefaultNullPolicy != TypeDefault {
+		return DefaultNullPolicy
+	}
+	return typeDefault
+}
+
+func (p NullPolicy) nulls(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return p > Strict
+	}
+	if p < EmptyAsNull {
+		return false
+	}
+	if _, isBytes := v.([]byte); (rv.Kind() == reflect.String || isBytes) && rv.Len() == 0 {
+		return true
+	}
+	if p != ZeroAsNull {
+		return false
+	}
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return true
+	}
+	switch z := v.(type) {
+	case interface{ IsZero() bool }:
+		return z.IsZero()
+	case interface{ Sign() int }:
+		return z.Sign() == 0
+	}
+	return rv.IsZero()
+}
+
+func (p NullPolicy) nullsSource(value interface{}) bool {
+	if p < EmptyAsNull {
+		return false
+	}
+	switch src := value.(type) {
+	case string:
+		return src == ""
+	case []byte:
+		return len(src) == 0
+	}
+	return false
+}
@@ -0,0 +1,229 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type nullableValue interface {
+	json.Marshaler
+	driver.Valuer
+}
+
+func TestNullPolicy_Marshal(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    nullable.NullPolicy
+		value     nullableValue
+		wantJSON  []byte
+		wantValue driver.Value
+	}{
+		{
+			name:      "should keep an empty string by default",
+			value:     *nullable.NewString(""),
+			wantJSON:  []byte(`""`),
+			wantValue: "",
+		},
+		{
+			name:      "should return null for an empty string",
+			value:     nullable.String{NullString: nullable.NewString("").NullString, Policy: nullable.EmptyAsNull},
+			wantJSON:  []byte("null"),
+			wantValue: nil,
+		},
+		{
+			name:      "should return null for an empty string due to the default policy",
+			policy:    nullable.EmptyAsNull,
+			value:     *nullable.NewString(""),
+			wantJSON:  []byte("null"),
+			wantValue: nil,
+		},
+		{
+			name:      "should keep zero when only empty values are null",
+			policy:    nullable.EmptyAsNull,
+			value:     *nullable.NewInt64(0),
+			wantJSON:  []byte("0"),
+			wantValue: int64(0),
+		},
+		{
+			name:      "should return null for zero",
+			value:     nullable.Int64{NullInt64: nullable.NewInt64(0).NullInt64, Policy: nullable.ZeroAsNull},
+			wantJSON:  []byte("null"),
+			wantValue: nil,
+		},
+		{
+			name:      "should return null for false",
+			policy:    nullable.ZeroAsNull,
+			value:     *nullable.NewBool(false),
+			wantJSON:  []byte("null"),
+			wantValue: nil,
+		},
+		{
+			name:      "should return null for a zero time by default, keeping it in the database",
+			value:     *nullable.NewTime(time.Time{}),
+			wantJSON:  []byte("null"),
+			wantValue: time.Time{},
+		},
+		{
+			name:      "should return null for a zero time",
+			value:     nullable.Time{NullTime: nullable.NewTime(time.Time{}).NullTime, Policy: nullable.ZeroAsNull},
+			wantJSON:  []byte("null"),
+			wantValue: nil,
+		},
+		{
+			name:      "should keep a zero time",
+			policy:    nullable.Strict,
+			value:     *nullable.NewTime(time.Time{}),
+			wantJSON:  []byte(`"0001-01-01T00:00:00Z"`),
+			wantValue: time.Time{},
+		},
+		{
+			name:      "should prefer the value policy over the default policy",
+			policy:    nullable.ZeroAsNull,
+			value:     nullable.Uint8{Uint8: 0, Valid: true, Policy: nullable.Strict},
+			wantJSON:  []byte("0"),
+			wantValue: int64(0),
+		},
+		{
+			name:      "should return null for a zero generic value",
+			value:     nullable.Of[status]{Null: nullable.New(status("")).Null, Policy: nullable.ZeroAsNull},
+			wantJSON:  []byte("null"),
+			wantValue: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(policy nullable.NullPolicy) { nullable.DefaultNullPolicy = policy }(nullable.DefaultNullPolicy)
+			nullable.DefaultNullPolicy = tt.policy
+			gotJSON, err := tt.value.MarshalJSON()
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(gotJSON, tt.wantJSON) {
+				t.Errorf("MarshalJSON() got = %s, want %s", gotJSON, tt.wantJSON)
+			}
+			gotValue, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(gotValue, tt.wantValue) {
+				t.Errorf("Value() got = %v, want %v", gotValue, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestNullPolicy_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    nullable.NullPolicy
+		data      []byte
+		scan      interface{}
+		wantValid bool
+	}{
+		{
+			name:      "should keep an empty string by default",
+			data:      []byte(`""`),
+			scan:      "",
+			wantValid: true,
+		},
+		{
+			name:      "should return null for an empty string",
+			policy:    nullable.EmptyAsNull,
+			data:      []byte(`""`),
+			scan:      []byte{},
+			wantValid: false,
+		},
+		{
+			name:      "should return null for an empty string when zero values are null",
+			policy:    nullable.ZeroAsNull,
+			data:      []byte(`""`),
+			scan:      "",
+			wantValid: false,
+		},
+		{
+			name:      "should keep a non empty string",
+			policy:    nullable.ZeroAsNull,
+			data:      []byte(`"test"`),
+			scan:      "test",
+			wantValid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(policy nullable.NullPolicy) { nullable.DefaultNullPolicy = policy }(nullable.DefaultNullPolicy)
+			nullable.DefaultNullPolicy = tt.policy
+			var fromJSON, fromScan nullable.String
+			if err := json.Unmarshal(tt.data, &fromJSON); err != nil {
+				t.Errorf("UnmarshalJSON() error = %v", err)
+				return
+			}
+			if fromJSON.Valid != tt.wantValid {
+				t.Errorf("UnmarshalJSON() got = %v, want valid %v", fromJSON, tt.wantValid)
+			}
+			if err := fromScan.Scan(tt.scan); err != nil {
+				t.Errorf("Scan() error = %v", err)
+				return
+			}
+			if fromScan.Valid != tt.wantValid {
+				t.Errorf("Scan() got = %v, want valid %v", fromScan, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestNullPolicy_UnmarshalNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  nullable.NullPolicy
+		data    []byte
+		scan    interface{}
+		want    nullable.Int64
+		wantErr bool
+	}{
+		{
+			name:    "should return an error for an empty string by default",
+			data:    []byte(`""`),
+			scan:    "",
+			wantErr: true,
+		},
+		{
+			name:   "should return null for an empty string",
+			policy: nullable.EmptyAsNull,
+			data:   []byte(`""`),
+			scan:   []byte(""),
+			want:   nullable.Int64{},
+		},
+		{
+			name:   "should return null for zero",
+			policy: nullable.ZeroAsNull,
+			data:   []byte(`0`),
+			scan:   int64(0),
+			want:   nullable.Int64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(policy nullable.NullPolicy) { nullable.DefaultNullPolicy = policy }(nullable.DefaultNullPolicy)
+			nullable.DefaultNullPolicy = tt.policy
+			var fromJSON, fromScan nullable.Int64
+			errJSON := json.Unmarshal(tt.data, &fromJSON)
+			errScan := fromScan.Scan(tt.scan)
+			if (errJSON != nil) != tt.wantErr || (errScan != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, Scan() error = %v, wantErr %v", errJSON, errScan, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(fromJSON, tt.want) || !reflect.DeepEqual(fromScan, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, Scan() got = %v, want %v", fromJSON, fromScan, tt.want)
+			}
+		})
+	}
+}
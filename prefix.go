@@ -0,0 +1,147 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// Prefix is a nullable IP network, for Postgres cidr and inet columns. An
+// address without a mask, as Postgres outputs inet hosts, is handled as a
+// single IP prefix, such as "10.0.0.1/32".
+type Prefix struct {
+	Prefix netip.Prefix
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewPrefix(v netip.Prefix) *Prefix {
+	return &Prefix{
+		Prefix: v,
+		Valid:  true,
+	}
+}
+
+func PrefixFrom(v netip.Prefix) Prefix {
+	return *NewPrefix(v)
+}
+
+func PrefixFromPtr(v *netip.Prefix) Prefix {
+	if v == nil {
+		return Prefix{}
+	}
+	return PrefixFrom(*v)
+}
+
+func (n Prefix) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Prefix) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Prefix)
+}
+
+func (n Prefix) Get() (netip.Prefix, bool) {
+	return get(n.Prefix, n.valid())
+}
+
+func (n Prefix) ValueOr(v netip.Prefix) netip.Prefix {
+	return valueOr(n.Prefix, n.valid(), v)
+}
+
+func (n Prefix) MustGet() netip.Prefix {
+	return mustGet(n.Prefix, n.valid())
+}
+
+func (n Prefix) Ptr() *netip.Prefix {
+	return ptr(n.Prefix, n.valid())
+}
+
+func (n *Prefix) Scan(value interface{}) error {
+	return scanValue(value, &n.Prefix, &n.Valid, n.policy(), toPrefix)
+}
+
+func (n Prefix) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return n.Prefix.String(), nil
+}
+
+func (n Prefix) MarshalJSON() ([]byte, error) {
+	return marshalJSON(prefixText(n.Prefix), n.valid())
+}
+
+func (n *Prefix) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, (*prefixText)(&n.Prefix), &n.Valid, n.policy())
+}
+
+func (n Prefix) MarshalText() ([]byte, error) {
+	return marshalText(prefixText(n.Prefix), n.valid())
+}
+
+func (n *Prefix) UnmarshalText(text []byte) error {
+	return unmarshalText(text, (*prefixText)(&n.Prefix), &n.Valid, n.policy())
+}
+
+func (n Prefix) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, prefixText(n.Prefix), n.valid())
+}
+
+func (n *Prefix) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, (*prefixText)(&n.Prefix), &n.Valid, n.policy())
+}
+
+func (n Prefix) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, prefixText(n.Prefix), n.valid())
+}
+
+func (n *Prefix) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, (*prefixText)(&n.Prefix), &n.Valid, n.policy())
+}
+
+type prefixText netip.Prefix
+
+func (p prefixText) IsZero() bool {
+	return !netip.Prefix(p).IsValid()
+}
+
+func (p prefixText) MarshalText() ([]byte, error) {
+	return netip.Prefix(p).MarshalText()
+}
+
+func (p *prefixText) UnmarshalText(text []byte) error {
+	v, err := parsePrefix(string(text))
+	if err != nil {
+		return err
+	}
+	*p = prefixText(v)
+	return nil
+}
+
+func toPrefix(value interface{}) (netip.Prefix, error) {
+	switch src := value.(type) {
+	case string:
+		return parsePrefix(src)
+	case []byte:
+		return parsePrefix(string(src))
+	}
+	return netip.Prefix{}, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type Prefix", value)
+}
+
+func parsePrefix(s string) (netip.Prefix, error) {
+	if !strings.Contains(s, "/") {
+		a, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("nullable: invalid IP prefix %q", s)
+		}
+		return netip.PrefixFrom(a, a.BitLen()), nil
+	}
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("nullable: invalid IP prefix %q", s)
+	}
+	return p, nil
+}
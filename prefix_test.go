@@ -0,0 +1,128 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+func TestPrefix_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []byte
+	}{
+		{
+			name:  "should return null",
+			value: nullable.Prefix{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should return a prefix",
+			value: *nullable.NewPrefix(netip.MustParsePrefix("10.0.0.0/8")),
+			want:  []byte(`"10.0.0.0/8"`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefix_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    nullable.Prefix
+		wantErr bool
+	}{
+		{
+			name:  "should return a null prefix",
+			value: nil,
+			want:  nullable.Prefix{},
+		},
+		{
+			name:  "should scan a cidr",
+			value: []byte("10.0.0.0/8"),
+			want:  *nullable.NewPrefix(netip.MustParsePrefix("10.0.0.0/8")),
+		},
+		{
+			name:  "should scan an inet with a mask",
+			value: "10.0.0.1/24",
+			want:  *nullable.NewPrefix(netip.MustParsePrefix("10.0.0.1/24")),
+		},
+		{
+			name:  "should scan an inet host",
+			value: "2001:db8::1",
+			want:  *nullable.NewPrefix(netip.MustParsePrefix("2001:db8::1/128")),
+		},
+		{
+			name:    "should return an error due to an invalid mask",
+			value:   "10.0.0.0/33",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unsupported type",
+			value:   int64(1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Prefix
+			err := n.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefix_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.Prefix
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.Prefix{},
+			want:  nil,
+		},
+		{
+			name:  "should return the prefix",
+			value: *nullable.NewPrefix(netip.MustParsePrefix("2001:DB8::/32")),
+			want:  "2001:db8::/32",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
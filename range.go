@@ -0,0 +1,438 @@
+package nullable
+
+import (
+	"cmp"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Range is a Postgres range of E, whose null bounds are unbounded. RangeOf wraps
+// it, adding null, and is scanned from and sent to the database
+// as range literals, like [2021-01-01,2021-02-01). Its text and XML forms are
+// range literals as well. Lower -infinity and upper infinity bounds, as found
+// in tstzrange and daterange values, are scanned as unbounded ends.
+type Range[E any] struct {
+	Lower          E    `json:"lower"`
+	Upper          E    `json:"upper"`
+	LowerInclusive bool `json:"lowerInclusive"`
+	UpperInclusive bool `json:"upperInclusive"`
+	Empty          bool `json:"empty,omitempty"`
+}
+
+// rangeElement is an element of RangeOf, holding values of T. Its rangeOps
+// return how T values are compared and, for discrete ranges, the value
+// following a given one.
+type rangeElement[T any] interface {
+	driver.Valuer
+	Get() (T, bool)
+	rangeOps() (compare func(T, T) int, next func(T) T)
+}
+
+// RangeOf is a nullable Range of E, whose bounds hold values of T.
+type RangeOf[T any, E rangeElement[T], P arrayElement[E]] struct {
+	Range  Range[E]
+	Valid  bool
+	Policy NullPolicy
+}
+
+type (
+	// Int32Range is a nullable Postgres int4range.
+	Int32Range = RangeOf[int32, Int32, *Int32]
+	// Int64Range is a nullable Postgres int8range.
+	Int64Range = RangeOf[int64, Int64, *Int64]
+	// DecimalRange is a nullable Postgres numrange.
+	DecimalRange = RangeOf[*big.Rat, Decimal, *Decimal]
+	// TimeRange is a nullable Postgres tstzrange.
+	TimeRange = RangeOf[time.Time, Time, *Time]
+	// DateRange is a nullable Postgres daterange.
+	DateRange = RangeOf[CivilDate, Date, *Date]
+)
+
+func (Int32) rangeOps() (func(int32, int32) int, func(int32) int32) {
+	return cmp.Compare[int32], nextInt[int32]
+}
+
+func (Int64) rangeOps() (func(int64, int64) int, func(int64) int64) {
+	return cmp.Compare[int64], nextInt[int64]
+}
+
+func (Decimal) rangeOps() (func(*big.Rat, *big.Rat) int, func(*big.Rat) *big.Rat) {
+	return (*big.Rat).Cmp, nil
+}
+
+func (Time) rangeOps() (func(time.Time, time.Time) int, func(time.Time) time.Time) {
+	return time.Time.Compare, nil
+}
+
+func (Date) rangeOps() (func(CivilDate, CivilDate) int, func(CivilDate) CivilDate) {
+	return CivilDate.Compare, CivilDate.next
+}
+
+func NewRange[T any, E rangeElement[T], P arrayElement[E]](v Range[E]) *RangeOf[T, E, P] {
+	return &RangeOf[T, E, P]{
+		Range: v,
+		Valid: true,
+	}
+}
+
+func RangeFrom[T any, E rangeElement[T], P arrayElement[E]](v Range[E]) RangeOf[T, E, P] {
+	return *NewRange[T, E, P](v)
+}
+
+func RangeFromPtr[T any, E rangeElement[T], P arrayElement[E]](v *Range[E]) RangeOf[T, E, P] {
+	if v == nil {
+		return RangeOf[T, E, P]{}
+	}
+	return RangeFrom[T, E, P](*v)
+}
+
+func (n *RangeOf[T, E, P]) codec() *rangeCodec[E, P] {
+	return &rangeCodec[E, P]{&n.Range}
+}
+
+func (n RangeOf[T, E, P]) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n RangeOf[T, E, P]) valid() bool {
+	return n.Valid && !n.policy().nulls(n.codec())
+}
+
+func (n RangeOf[T, E, P]) Get() (Range[E], bool) {
+	return get(n.Range, n.valid())
+}
+
+func (n RangeOf[T, E, P]) ValueOr(v Range[E]) Range[E] {
+	return valueOr(n.Range, n.valid(), v)
+}
+
+func (n RangeOf[T, E, P]) MustGet() Range[E] {
+	return mustGet(n.Range, n.valid())
+}
+
+func (n RangeOf[T, E, P]) Ptr() *Range[E] {
+	return ptr(n.Range, n.valid())
+}
+
+// Contains tells whether the range is valid and contains v.
+func (n RangeOf[T, E, P]) Contains(v T) bool {
+	var e E
+	compare, next := e.rangeOps()
+	return n.valid() && rangeContains(n.Range, v, compare, next)
+}
+
+// Overlaps tells whether both ranges are valid and have points in common.
+func (n RangeOf[T, E, P]) Overlaps(other RangeOf[T, E, P]) bool {
+	var e E
+	compare, next := e.rangeOps()
+	return n.valid() && other.valid() && rangeOverlaps(n.Range, other.Range, compare, next)
+}
+
+func (n *RangeOf[T, E, P]) Scan(value interface{}) error {
+	return scanValue(value, &n.Range, &n.Valid, n.policy(), n.codec().convert)
+}
+
+func (n RangeOf[T, E, P]) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return n.codec().value()
+}
+
+func (n RangeOf[T, E, P]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.codec(), n.valid())
+}
+
+func (n *RangeOf[T, E, P]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, n.codec(), &n.Valid, n.policy())
+}
+
+func (n RangeOf[T, E, P]) MarshalText() ([]byte, error) {
+	return marshalText(n.codec(), n.valid())
+}
+
+func (n *RangeOf[T, E, P]) UnmarshalText(text []byte) error {
+	return unmarshalText(text, n.codec(), &n.Valid, n.policy())
+}
+
+func (n RangeOf[T, E, P]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.codec(), n.valid())
+}
+
+func (n *RangeOf[T, E, P]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, n.codec(), &n.Valid, n.policy())
+}
+
+func (n RangeOf[T, E, P]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.codec(), n.valid())
+}
+
+func (n *RangeOf[T, E, P]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, n.codec(), &n.Valid, n.policy())
+}
+
+type rangeCodec[E driver.Valuer, P arrayElement[E]] struct {
+	r *Range[E]
+}
+
+func (c rangeCodec[E, P]) IsZero() bool {
+	return reflect.ValueOf(c.r).Elem().IsZero()
+}
+
+func (c rangeCodec[E, P]) MarshalText() ([]byte, error) {
+	if c.r.Empty {
+		return []byte("empty"), nil
+	}
+	var b strings.Builder
+	if c.r.LowerInclusive {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if err := formatRangeBound(&b, c.r.Lower); err != nil {
+		return nil, err
+	}
+	b.WriteByte(',')
+	if err := formatRangeBound(&b, c.r.Upper); err != nil {
+		return nil, err
+	}
+	if c.r.UpperInclusive {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+	return []byte(b.String()), nil
+}
+
+func (c *rangeCodec[E, P]) UnmarshalText(text []byte) error {
+	t, err := parseRange(string(text))
+	if err != nil {
+		return err
+	}
+	r := Range[E]{LowerInclusive: t.lowerInclusive, UpperInclusive: t.upperInclusive, Empty: t.empty}
+	if err := scanRangeBound(P(&r.Lower), t.lower, "-infinity"); err != nil {
+		return err
+	}
+	if err := scanRangeBound(P(&r.Upper), t.upper, "infinity"); err != nil {
+		return err
+	}
+	*c.r = r
+	return nil
+}
+
+// scanRangeBound scans the text of a bound, where the given infinity, such as
+// -infinity for lower bounds, is an unbounded end. The opposite infinity is
+// rejected.
+func scanRangeBound(bound sql.Scanner, text *string, infinity string) error {
+	if text == nil || strings.EqualFold(*text, infinity) {
+		return bound.Scan(nil)
+	}
+	if strings.EqualFold(strings.TrimPrefix(*text, "-"), "infinity") {
+		return fmt.Errorf("nullable: unsupported range bound %q", *text)
+	}
+	return bound.Scan(*text)
+}
+
+func (c rangeCodec[E, P]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(*c.r)
+}
+
+func (c *rangeCodec[E, P]) UnmarshalJSON(data []byte) error {
+	var r Range[E]
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+	*c.r = r
+	return nil
+}
+
+func (c rangeCodec[E, P]) value() (driver.Value, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+func (c rangeCodec[E, P]) convert(value interface{}) (Range[E], error) {
+	var r Range[E]
+	var err error
+	switch src := value.(type) {
+	case string:
+		err = (&rangeCodec[E, P]{&r}).UnmarshalText([]byte(src))
+	case []byte:
+		err = (&rangeCodec[E, P]{&r}).UnmarshalText(src)
+	default:
+		err = fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type %T", value, r)
+	}
+	return r, err
+}
+
+func formatRangeBound(b *strings.Builder, bound driver.Valuer) error {
+	v, err := bound.Value()
+	if err != nil || v == nil {
+		return err
+	}
+	text, _, err := elementText(v)
+	if err != nil {
+		return err
+	}
+	if text == "" || strings.ContainsAny(text, `,()[]"\ `) {
+		quoteArrayElement(b, text)
+	} else {
+		b.WriteString(text)
+	}
+	return nil
+}
+
+type rangeText struct {
+	lower, upper                   *string
+	lowerInclusive, upperInclusive bool
+	empty                          bool
+}
+
+func parseRange(s string) (rangeText, error) {
+	var t rangeText
+	text := strings.TrimSpace(s)
+	if strings.EqualFold(text, "empty") {
+		t.empty = true
+		return t, nil
+	}
+	if len(text) < 3 || !strings.ContainsRune("[(", rune(text[0])) || !strings.ContainsRune("])", rune(text[len(text)-1])) {
+		return t, fmt.Errorf("nullable: invalid range %q", s)
+	}
+	t.lowerInclusive, t.upperInclusive = text[0] == '[', text[len(text)-1] == ']'
+	text = text[1 : len(text)-1]
+	var (
+		i   int
+		err error
+	)
+	if t.lower, i, err = parseRangeBound(text, 0); err != nil || i >= len(text) || text[i] != ',' {
+		return t, fmt.Errorf("nullable: invalid range %q", s)
+	}
+	if t.upper, i, err = parseRangeBound(text, i+1); err != nil || i != len(text) {
+		return t, fmt.Errorf("nullable: invalid range %q", s)
+	}
+	return t, nil
+}
+
+// parseRangeBound parses the bound starting at text[i], returning nil for
+// unbounded ends and the index following the bound.
+func parseRangeBound(text string, i int) (*string, int, error) {
+	var (
+		b     strings.Builder
+		bound bool
+	)
+	for i < len(text) && text[i] != ',' {
+		switch text[i] {
+		case '"':
+			bound = true
+			for i++; i < len(text); i++ {
+				if text[i] == '"' {
+					if i+1 < len(text) && text[i+1] == '"' {
+						i++
+					} else {
+						break
+					}
+				} else if text[i] == '\\' && i+1 < len(text) {
+					i++
+				}
+				b.WriteByte(text[i])
+			}
+			if i >= len(text) {
+				return nil, i, fmt.Errorf("unterminated quoted bound")
+			}
+		case '\\':
+			if i+1 < len(text) {
+				i++
+			}
+			fallthrough
+		default:
+			bound = true
+			b.WriteByte(text[i])
+		}
+		i++
+	}
+	if !bound {
+		return nil, i, nil
+	}
+	s := b.String()
+	return &s, i, nil
+}
+
+// bounds holds the bounds of a range, where the has fields are false for
+// unbounded ends.
+type bounds[T any] struct {
+	lower, upper                   T
+	hasLower, hasUpper             bool
+	lowerInclusive, upperInclusive bool
+	empty                          bool
+}
+
+// rangeBounds returns the bounds of r. When next is given, the range is
+// discrete and its bounds are converted to the canonical [lower,upper) form,
+// unless they are the maximum value, whose next one overflows.
+func rangeBounds[T any, E interface{ Get() (T, bool) }](r Range[E], cmp func(T, T) int, next func(T) T) bounds[T] {
+	b := bounds[T]{lowerInclusive: r.LowerInclusive, upperInclusive: r.UpperInclusive, empty: r.Empty}
+	b.lower, b.hasLower = r.Lower.Get()
+	b.upper, b.hasUpper = r.Upper.Get()
+	if next != nil {
+		if b.hasLower && !b.lowerInclusive {
+			if n := next(b.lower); cmp(n, b.lower) > 0 {
+				b.lower, b.lowerInclusive = n, true
+			}
+		}
+		if b.hasUpper && b.upperInclusive {
+			if n := next(b.upper); cmp(n, b.upper) > 0 {
+				b.upper, b.upperInclusive = n, false
+			}
+		}
+	}
+	if b.hasLower && b.hasUpper {
+		c := cmp(b.lower, b.upper)
+		b.empty = b.empty || c > 0 || (c == 0 && !(b.lowerInclusive && b.upperInclusive))
+	}
+	return b
+}
+
+func rangeContains[T any, E interface{ Get() (T, bool) }](r Range[E], v T, cmp func(T, T) int, next func(T) T) bool {
+	b := rangeBounds(r, cmp, next)
+	if b.empty {
+		return false
+	}
+	if b.hasLower {
+		if c := cmp(b.lower, v); c > 0 || (c == 0 && !b.lowerInclusive) {
+			return false
+		}
+	}
+	if b.hasUpper {
+		if c := cmp(v, b.upper); c > 0 || (c == 0 && !b.upperInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+func rangeOverlaps[T any, E interface{ Get() (T, bool) }](r, other Range[E], cmp func(T, T) int, next func(T) T) bool {
+	a, b := rangeBounds(r, cmp, next), rangeBounds(other, cmp, next)
+	return !a.empty && !b.empty && lowerBeforeUpper(a, b, cmp) && lowerBeforeUpper(b, a, cmp)
+}
+
+func lowerBeforeUpper[T any](a, b bounds[T], cmp func(T, T) int) bool {
+	if !a.hasLower || !b.hasUpper {
+		return true
+	}
+	c := cmp(a.lower, b.upper)
+	return c < 0 || (c == 0 && a.lowerInclusive && b.upperInclusive)
+}
+
+func nextInt[T int32 | int64](v T) T {
+	return v + 1
+}
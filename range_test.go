@@ -0,0 +1,440 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func int64Range(lower, upper nullable.Int64, lowerInclusive, upperInclusive bool) nullable.Int64Range {
+	return nullable.RangeFrom(nullable.Range[nullable.Int64]{
+		Lower:          lower,
+		Upper:          upper,
+		LowerInclusive: lowerInclusive,
+		UpperInclusive: upperInclusive,
+	})
+}
+
+func dateRange(lower, upper string) nullable.DateRange {
+	r := nullable.Range[nullable.Date]{LowerInclusive: true}
+	if lower != "" {
+		r.Lower = nullable.DateFrom(mustParseCivilDate(lower))
+	}
+	if upper != "" {
+		r.Upper = nullable.DateFrom(mustParseCivilDate(upper))
+	}
+	return nullable.RangeFrom(r)
+}
+
+func mustParseCivilDate(s string) nullable.CivilDate {
+	d, err := nullable.ParseCivilDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestRange_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []byte
+	}{
+		{
+			name:  "should return null",
+			value: nullable.Int64Range{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should return a bounded range",
+			value: int64Range(nullable.Int64From(1), nullable.Int64From(10), true, false),
+			want:  []byte(`{"lower":1,"upper":10,"lowerInclusive":true,"upperInclusive":false}`),
+		},
+		{
+			name:  "should return an unbounded range",
+			value: dateRange("2021-01-01", ""),
+			want:  []byte(`{"lower":"2021-01-01","upper":null,"lowerInclusive":true,"upperInclusive":false}`),
+		},
+		{
+			name:  "should return an empty range",
+			value: nullable.RangeFrom(nullable.Range[nullable.Int32]{Empty: true}),
+			want:  []byte(`{"lower":null,"upper":null,"lowerInclusive":false,"upperInclusive":false,"empty":true}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    nullable.Int64Range
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			data: []byte("null"),
+			want: nullable.Int64Range{},
+		},
+		{
+			name: "should unmarshal a range",
+			data: []byte(`{"lower":1,"upper":null,"lowerInclusive":true}`),
+			want: int64Range(nullable.Int64From(1), nullable.Int64{}, true, false),
+		},
+		{
+			name: "should unmarshal an empty range",
+			data: []byte(`{"empty":true}`),
+			want: nullable.RangeFrom(nullable.Range[nullable.Int64]{Empty: true}),
+		},
+		{
+			name:    "should return an error due to an invalid bound",
+			data:    []byte(`{"lower":"a"}`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Int64Range
+			err := json.Unmarshal(tt.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		dest    sql.Scanner
+		value   interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:  "should return a null range",
+			dest:  &nullable.Int64Range{},
+			value: nil,
+			want:  &nullable.Int64Range{},
+		},
+		{
+			name:  "should scan an empty range",
+			dest:  &nullable.Int64Range{},
+			value: []byte("empty"),
+			want:  nullable.NewRange(nullable.Range[nullable.Int64]{Empty: true}),
+		},
+		{
+			name:  "should scan an int8range",
+			dest:  &nullable.Int64Range{},
+			value: []byte("[1,10)"),
+			want: nullable.NewRange(nullable.Range[nullable.Int64]{
+				Lower:          nullable.Int64From(1),
+				Upper:          nullable.Int64From(10),
+				LowerInclusive: true,
+			}),
+		},
+		{
+			name:  "should scan an unbounded int4range",
+			dest:  &nullable.Int32Range{},
+			value: "(,5]",
+			want: nullable.NewRange(nullable.Range[nullable.Int32]{
+				Upper:          nullable.Int32From(5),
+				UpperInclusive: true,
+			}),
+		},
+		{
+			name:  "should scan a numrange",
+			dest:  &nullable.DecimalRange{},
+			value: "(1.5,2.25]",
+			want: nullable.NewRange(nullable.Range[nullable.Decimal]{
+				Lower:          nullable.DecimalFrom(big.NewRat(3, 2)),
+				Upper:          nullable.DecimalFrom(big.NewRat(9, 4)),
+				UpperInclusive: true,
+			}),
+		},
+		{
+			name:  "should scan a daterange",
+			dest:  &nullable.DateRange{},
+			value: "[2021-01-01,2021-02-01)",
+			want: func() *nullable.DateRange {
+				r := dateRange("2021-01-01", "2021-02-01")
+				return &r
+			}(),
+		},
+		{
+			name:  "should scan a tstzrange",
+			dest:  &nullable.TimeRange{},
+			value: `["2021-11-23 12:10:00+02",)`,
+			want: nullable.NewRange(nullable.Range[nullable.Time]{
+				Lower:          nullable.TimeFrom(time.Date(2021, 11, 23, 12, 10, 0, 0, time.FixedZone("", 2*60*60))),
+				LowerInclusive: true,
+			}),
+		},
+		{
+			name:  "should scan an infinite upper bound as unbounded",
+			dest:  &nullable.DateRange{},
+			value: "[2021-01-01,infinity)",
+			want: nullable.NewRange(nullable.Range[nullable.Date]{
+				Lower:          nullable.DateFrom(nullable.CivilDate{Year: 2021, Month: time.January, Day: 1}),
+				LowerInclusive: true,
+			}),
+		},
+		{
+			name:  "should scan an infinite lower bound as unbounded",
+			dest:  &nullable.TimeRange{},
+			value: `("-infinity","2021-11-23 12:10:00+02")`,
+			want: nullable.NewRange(nullable.Range[nullable.Time]{
+				Upper: nullable.TimeFrom(time.Date(2021, 11, 23, 12, 10, 0, 0, time.FixedZone("", 2*60*60))),
+			}),
+		},
+		{
+			name:    "should return an error due to an infinite lower bound",
+			dest:    &nullable.DateRange{},
+			value:   "[infinity,)",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to a negative infinite upper bound",
+			dest:    &nullable.TimeRange{},
+			value:   "(,-infinity)",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an invalid bound",
+			dest:    &nullable.Int64Range{},
+			value:   "[a,1)",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to a missing bound",
+			dest:    &nullable.Int64Range{},
+			value:   "[1)",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an invalid range",
+			dest:    &nullable.Int64Range{},
+			value:   "1,2",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unsupported type",
+			dest:    &nullable.Int64Range{},
+			value:   int64(1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.dest.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(tt.dest, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", tt.dest, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value driver.Valuer
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.Int64Range{},
+			want:  nil,
+		},
+		{
+			name:  "should return an empty range",
+			value: nullable.RangeFrom(nullable.Range[nullable.Int64]{Empty: true}),
+			want:  "empty",
+		},
+		{
+			name:  "should return a bounded range",
+			value: int64Range(nullable.Int64From(1), nullable.Int64From(10), true, false),
+			want:  "[1,10)",
+		},
+		{
+			name:  "should return an unbounded range",
+			value: int64Range(nullable.Int64{}, nullable.Int64{}, false, false),
+			want:  "(,)",
+		},
+		{
+			name:  "should return a daterange",
+			value: dateRange("2021-01-01", "2021-02-01"),
+			want:  "[2021-01-01,2021-02-01)",
+		},
+		{
+			name:  "should return a numrange",
+			value: nullable.RangeFrom(nullable.Range[nullable.Decimal]{Lower: nullable.DecimalFrom(big.NewRat(3, 2))}),
+			want:  "(1.5,)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_Contains(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.Int64Range
+		v     int64
+		want  bool
+	}{
+		{
+			name:  "should not contain values of a null range",
+			value: nullable.Int64Range{},
+			v:     1,
+			want:  false,
+		},
+		{
+			name:  "should not contain values of an empty range",
+			value: nullable.RangeFrom(nullable.Range[nullable.Int64]{Empty: true}),
+			v:     1,
+			want:  false,
+		},
+		{
+			name:  "should contain the inclusive lower bound",
+			value: int64Range(nullable.Int64From(1), nullable.Int64From(10), true, false),
+			v:     1,
+			want:  true,
+		},
+		{
+			name:  "should not contain the exclusive upper bound",
+			value: int64Range(nullable.Int64From(1), nullable.Int64From(10), true, false),
+			v:     10,
+			want:  false,
+		},
+		{
+			name:  "should contain values of an unbounded range",
+			value: int64Range(nullable.Int64{}, nullable.Int64From(10), false, false),
+			v:     -100,
+			want:  true,
+		},
+		{
+			name:  "should contain values of a range up to the maximum value",
+			value: int64Range(nullable.Int64From(1), nullable.Int64From(math.MaxInt64), true, true),
+			v:     5,
+			want:  true,
+		},
+		{
+			name:  "should contain the maximum value as an inclusive upper bound",
+			value: int64Range(nullable.Int64From(1), nullable.Int64From(math.MaxInt64), true, true),
+			v:     math.MaxInt64,
+			want:  true,
+		},
+		{
+			name:  "should not contain values after the maximum value as an exclusive lower bound",
+			value: int64Range(nullable.Int64From(math.MaxInt64), nullable.Int64{}, false, false),
+			v:     math.MaxInt64,
+			want:  false,
+		},
+		{
+			name:  "should not contain values of a canonically empty range",
+			value: int64Range(nullable.Int64From(1), nullable.Int64From(2), false, false),
+			v:     1,
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.Contains(tt.v); got != tt.want {
+				t.Errorf("Contains() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_Overlaps(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.DateRange
+		other nullable.DateRange
+		want  bool
+	}{
+		{
+			name:  "should overlap",
+			value: dateRange("2021-01-01", "2021-02-01"),
+			other: dateRange("2021-01-15", "2021-03-01"),
+			want:  true,
+		},
+		{
+			name:  "should not overlap adjacent ranges",
+			value: dateRange("2021-01-01", "2021-02-01"),
+			other: dateRange("2021-02-01", "2021-03-01"),
+			want:  false,
+		},
+		{
+			name:  "should overlap unbounded ranges",
+			value: dateRange("", "2021-02-01"),
+			other: dateRange("2021-01-31", ""),
+			want:  true,
+		},
+		{
+			name: "should overlap inclusive discrete bounds",
+			value: nullable.RangeFrom(nullable.Range[nullable.Date]{
+				Lower:          nullable.DateFrom(mustParseCivilDate("2021-01-01")),
+				Upper:          nullable.DateFrom(mustParseCivilDate("2021-01-31")),
+				LowerInclusive: true,
+				UpperInclusive: true,
+			}),
+			other: dateRange("2021-01-31", ""),
+			want:  true,
+		},
+		{
+			name:  "should not overlap a null range",
+			value: dateRange("2021-01-01", "2021-02-01"),
+			other: nullable.DateRange{},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.Overlaps(tt.other); got != tt.want {
+				t.Errorf("Overlaps() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
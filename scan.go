@@ -0,0 +1,179 @@
+package nullable
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+var ErrOutOfRange = errors.New("nullable: value out of range")
+
+type unsigned interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+func scanValue[T any](value interface{}, v *T, valid *bool, p NullPolicy, convert func(interface{}) (T, error)) error {
+	if value == nil || p.nullsSource(value) {
+		var zero T
+		*v, *valid = zero, false
+		return nil
+	}
+	converted, err := convert(value)
+	if err != nil {
+		return err
+	}
+	*v, *valid = converted, !p.nulls(converted)
+	return nil
+}
+
+func convertAssign[T any](value interface{}) (T, error) {
+	var n sql.Null[T]
+	err := n.Scan(value)
+	return n.V, err
+}
+
+func scanUint[T unsigned](value interface{}, v *T, valid *bool, p NullPolicy, bitSize int) error {
+	return scanValue(value, v, valid, p, func(value interface{}) (T, error) {
+		u, err := toUint64(value, bitSize)
+		if err != nil {
+			return 0, err
+		}
+		if bitSize < 64 && u > 1<<bitSize-1 {
+			return 0, fmt.Errorf("%w: cannot scan %d into uint%d", ErrOutOfRange, u, bitSize)
+		}
+		return T(u), nil
+	})
+}
+
+func toUint64(value interface{}, bitSize int) (uint64, error) {
+	switch src := value.(type) {
+	case int64:
+		if src < 0 {
+			return 0, fmt.Errorf("%w: cannot scan %d into uint%d", ErrOutOfRange, src, bitSize)
+		}
+		return uint64(src), nil
+	case uint64:
+		return src, nil
+	case float64:
+		if src != math.Trunc(src) {
+			return 0, fmt.Errorf("nullable: cannot scan %v into uint%d: not an integer", src, bitSize)
+		}
+		if src < 0 || src >= math.MaxUint64 {
+			return 0, fmt.Errorf("%w: cannot scan %v into uint%d", ErrOutOfRange, src, bitSize)
+		}
+		return uint64(src), nil
+	case string:
+		return parseUint(src, bitSize)
+	case []byte:
+		return parseUint(string(src), bitSize)
+	}
+	return 0, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type uint%d", value, bitSize)
+}
+
+func parseUint(s string, bitSize int) (uint64, error) {
+	u, err := strconv.ParseUint(s, 10, 64)
+	if errors.Is(err, strconv.ErrRange) || (err != nil && len(s) > 0 && s[0] == '-') {
+		return 0, fmt.Errorf("%w: cannot scan %q into uint%d", ErrOutOfRange, s, bitSize)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("nullable: cannot scan %q into uint%d: %w", s, bitSize, err)
+	}
+	return u, nil
+}
+
+type signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+func scanInt[T signed](value interface{}, v *T, valid *bool, p NullPolicy, bitSize int) error {
+	return scanValue(value, v, valid, p, func(value interface{}) (T, error) {
+		i, err := toInt64(value, bitSize)
+		if err != nil {
+			return 0, err
+		}
+		if bitSize < 64 && (i < -1<<(bitSize-1) || i > 1<<(bitSize-1)-1) {
+			return 0, fmt.Errorf("%w: cannot scan %d into int%d", ErrOutOfRange, i, bitSize)
+		}
+		return T(i), nil
+	})
+}
+
+func toInt64(value interface{}, bitSize int) (int64, error) {
+	switch src := value.(type) {
+	case int64:
+		return src, nil
+	case uint64:
+		if src > math.MaxInt64 {
+			return 0, fmt.Errorf("%w: cannot scan %d into int%d", ErrOutOfRange, src, bitSize)
+		}
+		return int64(src), nil
+	case float64:
+		if src != math.Trunc(src) {
+			return 0, fmt.Errorf("nullable: cannot scan %v into int%d: not an integer", src, bitSize)
+		}
+		if src < math.MinInt64 || src >= math.MaxInt64 {
+			return 0, fmt.Errorf("%w: cannot scan %v into int%d", ErrOutOfRange, src, bitSize)
+		}
+		return int64(src), nil
+	case string:
+		return parseInt(src, bitSize)
+	case []byte:
+		return parseInt(string(src), bitSize)
+	}
+	return 0, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type int%d", value, bitSize)
+}
+
+func parseInt(s string, bitSize int) (int64, error) {
+	i, err := strconv.ParseInt(s, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("%w: cannot scan %q into int%d", ErrOutOfRange, s, bitSize)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("nullable: cannot scan %q into int%d: %w", s, bitSize, err)
+	}
+	return i, nil
+}
+
+func scanFloat32(value interface{}, v *float32, valid *bool, p NullPolicy) error {
+	return scanValue(value, v, valid, p, toFloat32)
+}
+
+func toFloat32(value interface{}) (float32, error) {
+	var f float64
+	switch src := value.(type) {
+	case float64:
+		f = src
+	case float32:
+		f = float64(src)
+	case int64:
+		f = float64(src)
+	case string, []byte:
+		s := asString(src)
+		parsed, err := strconv.ParseFloat(s, 64)
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, fmt.Errorf("%w: cannot scan %q into float32", ErrOutOfRange, s)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("nullable: cannot scan %q into float32: %w", s, err)
+		}
+		f = parsed
+	default:
+		return 0, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type float32", value)
+	}
+	if !math.IsInf(f, 0) && math.Abs(f) > math.MaxFloat32 {
+		return 0, fmt.Errorf("%w: cannot scan %v into float32", ErrOutOfRange, f)
+	}
+	return float32(f), nil
+}
+
+func asString(src interface{}) string {
+	switch v := src.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+	return fmt.Sprintf("%v", src)
+}
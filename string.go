@@ -1,38 +1,97 @@
 package nullable
 
 import (
-	"bytes"
 	"database/sql"
-	"encoding/json"
+	"database/sql/driver"
+	"encoding/xml"
 )
 
 type String struct {
 	sql.NullString
+	Policy NullPolicy
 }
 
 func NewString(v string) *String {
-	return &String{sql.NullString{
+	return &String{NullString: sql.NullString{
 		String: v,
 		Valid:  true,
 	}}
 }
 
-func (n String) MarshalJSON() ([]byte, error) {
-	if !n.Valid {
-		return jsonNullBytes, nil
-	}
-	return json.Marshal(n.String)
+func StringFrom(v string) String {
+	return *NewString(v)
 }
 
-func (n *String) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, jsonNullBytes) {
-		n.Valid = false
-		return nil
+func StringFromPtr(v *string) String {
+	if v == nil {
+		return String{}
 	}
-	err := json.Unmarshal(data, &n.String)
-	if err != nil {
-		return err
+	return StringFrom(*v)
+}
+
+func (n String) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n String) valid() bool {
+	return n.Valid && !n.policy().nulls(n.String)
+}
+
+func (n String) Get() (string, bool) {
+	return get(n.String, n.valid())
+}
+
+func (n String) ValueOr(v string) string {
+	return valueOr(n.String, n.valid(), v)
+}
+
+func (n String) MustGet() string {
+	return mustGet(n.String, n.valid())
+}
+
+func (n String) Ptr() *string {
+	return ptr(n.String, n.valid())
+}
+
+func (n *String) Scan(value interface{}) error {
+	return scanValue(value, &n.String, &n.Valid, n.policy(), convertAssign[string])
+}
+
+func (n String) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
 	}
-	n.Valid = true
-	return nil
+	return n.NullString.Value()
+}
+
+func (n String) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.String, n.valid())
+}
+
+func (n *String) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.String, &n.Valid, n.policy())
+}
+
+func (n String) MarshalText() ([]byte, error) {
+	return marshalText(n.String, n.valid())
+}
+
+func (n *String) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.String, &n.Valid, n.policy())
+}
+
+func (n String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.String, n.valid())
+}
+
+func (n *String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.String, &n.Valid, n.policy())
+}
+
+func (n String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.String, n.valid())
+}
+
+func (n *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.String, &n.Valid, n.policy())
 }
@@ -0,0 +1,91 @@
+package nullable
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// NullText is the text representation of null values, used by MarshalText and
+// UnmarshalText.
+var NullText = []byte("")
+
+func marshalText(v interface{}, valid bool) ([]byte, error) {
+	if !valid {
+		return NullText, nil
+	}
+	return formatText(v)
+}
+
+func formatText(v interface{}) ([]byte, error) {
+	if m, ok := v.(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	}
+	return nil, fmt.Errorf("nullable: cannot marshal type %T as text", v)
+}
+
+func unmarshalText(text []byte, v interface{}, valid *bool, p NullPolicy) error {
+	if bytes.Equal(text, NullText) {
+		*valid = false
+		return nil
+	}
+	if err := parseText(text, v); err != nil {
+		return err
+	}
+	*valid = !p.nulls(reflect.ValueOf(v).Elem().Interface())
+	return nil
+}
+
+func parseText(text []byte, v interface{}) error {
+	if u, ok := v.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText(text)
+	}
+	rv := reflect.ValueOf(v).Elem()
+	s := string(text)
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("nullable: cannot unmarshal %q into %s: %w", s, rv.Type(), err)
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("nullable: cannot unmarshal %q into %s: %w", s, rv.Type(), err)
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("nullable: cannot unmarshal %q into %s: %w", s, rv.Type(), err)
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("nullable: cannot unmarshal %q into %s: %w", s, rv.Type(), err)
+		}
+		rv.SetFloat(f)
+	default:
+		return fmt.Errorf("nullable: cannot unmarshal text into type %s", rv.Type())
+	}
+	return nil
+}
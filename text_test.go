@@ -0,0 +1,165 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"github.com/diegohordi/nullable"
+	"math/big"
+	"net"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+// encodedValue is implemented by all the types of the package.
+type encodedValue interface {
+	driver.Valuer
+	encoding.TextMarshaler
+	xml.Marshaler
+	xml.MarshalerAttr
+}
+
+type encodingTest struct {
+	name    string
+	value   encodedValue
+	text    string
+	dbValue driver.Value
+}
+
+// encodingTests returns a valid value of each type, along with its text and
+// the value sent to the database. The zero value of each type is null.
+func encodingTests(t *testing.T) []encodingTest {
+	t.Helper()
+	url, err := nullable.ParseURL("https://example.com/path")
+	if err != nil {
+		t.Fatalf("ParseURL() error = %v", err)
+	}
+	timeOfDay, err := nullable.ParseCivilTime("12:10:00")
+	if err != nil {
+		t.Fatalf("ParseCivilTime() error = %v", err)
+	}
+	mac := net.HardwareAddr{0x00, 0x1a, 0x2b, 0x3c, 0x4d, 0x5e}
+	return []encodingTest{
+		{name: "Bool", value: nullable.BoolFrom(true), text: "true", dbValue: true},
+		{name: "Byte", value: nullable.ByteFrom('a'), text: "97", dbValue: int64(97)},
+		{name: "Bytes", value: nullable.BytesFrom([]byte("hi")), text: "aGk=", dbValue: []byte("hi")},
+		{name: "Date", value: nullable.DateFrom(dateRef), text: "2021-11-23", dbValue: "2021-11-23"},
+		{name: "Decimal", value: nullable.DecimalFrom(big.NewRat(3, 2)), text: "1.5", dbValue: "1.5"},
+		{name: "DecimalString", value: nullable.DecimalStringFrom(big.NewRat(3, 2)), text: "1.5", dbValue: "1.5"},
+		{name: "Duration", value: nullable.DurationFrom(durationRef), text: "1h30m0s", dbValue: int64(durationRef)},
+		{name: "Float32", value: nullable.Float32From(1.5), text: "1.5", dbValue: 1.5},
+		{name: "Float64", value: nullable.Float64From(1.5), text: "1.5", dbValue: 1.5},
+		{
+			name:    "HardwareAddr",
+			value:   nullable.HardwareAddrFrom(mac),
+			text:    "00:1a:2b:3c:4d:5e",
+			dbValue: "00:1a:2b:3c:4d:5e",
+		},
+		{name: "Int", value: nullable.IntFrom(-100), text: "-100", dbValue: int64(-100)},
+		{name: "Int8", value: nullable.Int8From(-100), text: "-100", dbValue: int64(-100)},
+		{name: "Int16", value: nullable.Int16From(-100), text: "-100", dbValue: int64(-100)},
+		{name: "Int32", value: nullable.Int32From(-100), text: "-100", dbValue: int64(-100)},
+		{name: "Int64", value: nullable.Int64From(-100), text: "-100", dbValue: int64(-100)},
+		{name: "Int64String", value: nullable.Int64StringFrom(-100), text: "-100", dbValue: int64(-100)},
+		{name: "IP", value: nullable.IPFrom(netip.MustParseAddr("10.0.0.1")), text: "10.0.0.1", dbValue: "10.0.0.1"},
+		{
+			name:    "Prefix",
+			value:   nullable.PrefixFrom(netip.MustParsePrefix("10.0.0.0/8")),
+			text:    "10.0.0.0/8",
+			dbValue: "10.0.0.0/8",
+		},
+		{
+			name:    "JSON",
+			value:   nullable.JSONFrom(payload{Name: "nullable"}),
+			text:    `{"name":"nullable"}`,
+			dbValue: `{"name":"nullable"}`,
+		},
+		{name: "RawJSON", value: nullable.RawJSONFrom(json.RawMessage(`[1,2]`)), text: "[1,2]", dbValue: "[1,2]"},
+		{name: "String", value: nullable.StringFrom("test"), text: "test", dbValue: "test"},
+		{name: "Time", value: nullable.TimeFrom(timeRef), text: timeRefStr, dbValue: timeRef},
+		{name: "TimeOfDay", value: nullable.TimeOfDayFrom(timeOfDay), text: "12:10:00", dbValue: "12:10:00"},
+		{name: "Uint8", value: nullable.Uint8From(200), text: "200", dbValue: int64(200)},
+		{name: "Uint16", value: nullable.Uint16From(200), text: "200", dbValue: int64(200)},
+		{name: "Uint32", value: nullable.Uint32From(200), text: "200", dbValue: int64(200)},
+		{name: "Uint64", value: nullable.Uint64From(200), text: "200", dbValue: int64(200)},
+		{name: "Uint64String", value: nullable.Uint64StringFrom(200), text: "200", dbValue: int64(200)},
+		{name: "UnixTime", value: nullable.UnixTimeFrom(timeRef), text: "1637669400", dbValue: timeRef},
+		{name: "UnixMilliTime", value: nullable.UnixMilliTimeFrom(timeRef), text: "1637669400000", dbValue: timeRef},
+		{name: "URL", value: url, text: "https://example.com/path", dbValue: "https://example.com/path"},
+		{name: "UUID", value: nullable.UUIDFrom(uuidRef), text: uuidRefStr, dbValue: uuidRefStr},
+		{name: "Of", value: nullable.From(status("active")), text: "active", dbValue: "active"},
+		{
+			name:    "Int64Array",
+			value:   nullable.ArrayFrom([]nullable.Int64{nullable.Int64From(1), {}}),
+			text:    "{1,NULL}",
+			dbValue: "{1,NULL}",
+		},
+		{
+			name:    "Int64Range",
+			value:   nullable.RangeFrom(nullable.Range[nullable.Int64]{Lower: nullable.Int64From(1), LowerInclusive: true}),
+			text:    "[1,)",
+			dbValue: "[1,)",
+		},
+	}
+}
+
+// emptyText tells whether an empty text is a valid value of the given type,
+// instead of null.
+func emptyText(v encodedValue) bool {
+	switch v.(type) {
+	case nullable.String, nullable.Of[status]:
+		return true
+	}
+	return false
+}
+
+func TestText_Encoding(t *testing.T) {
+	for _, tt := range encodingTests(t) {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil || !reflect.DeepEqual(got, tt.dbValue) {
+				t.Errorf("Value() got = %#v, error = %v, want %#v", got, err, tt.dbValue)
+			}
+			text, err := tt.value.MarshalText()
+			if err != nil || string(text) != tt.text {
+				t.Errorf("MarshalText() got = %s, error = %v, want %s", text, err, tt.text)
+			}
+			n := reflect.New(reflect.TypeOf(tt.value))
+			if err := n.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(tt.text)); err != nil {
+				t.Errorf("UnmarshalText() error = %v", err)
+				return
+			}
+			if text, _ := n.Elem().Interface().(encodedValue).MarshalText(); string(text) != tt.text {
+				t.Errorf("UnmarshalText() got = %s, want %s", text, tt.text)
+			}
+			err = n.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte("\x00"))
+			if err == nil && !emptyText(tt.value) {
+				t.Errorf("UnmarshalText() expected an error")
+			}
+		})
+	}
+}
+
+func TestText_EncodingNull(t *testing.T) {
+	for _, tt := range encodingTests(t) {
+		t.Run(tt.name, func(t *testing.T) {
+			null := reflect.Zero(reflect.TypeOf(tt.value)).Interface().(encodedValue)
+			if got, err := null.Value(); err != nil || got != nil {
+				t.Errorf("Value() got = %v, error = %v, want nil", got, err)
+			}
+			if text, err := null.MarshalText(); err != nil || len(text) != 0 {
+				t.Errorf("MarshalText() got = %s, error = %v, want an empty text", text, err)
+			}
+			n := reflect.New(reflect.TypeOf(tt.value))
+			if err := n.Interface().(encoding.TextUnmarshaler).UnmarshalText(nil); err != nil {
+				t.Errorf("UnmarshalText() error = %v", err)
+				return
+			}
+			if got, _ := n.Elem().Interface().(encodedValue).Value(); got != nil && !emptyText(tt.value) {
+				t.Errorf("UnmarshalText() got = %v, want null", got)
+			}
+		})
+	}
+}
@@ -1,38 +1,145 @@
 package nullable
 
 import (
-	"bytes"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/xml"
 	"time"
 )
 
 type Time struct {
 	sql.NullTime
+	Policy NullPolicy
 }
 
 func NewTime(v time.Time) *Time {
-	return &Time{sql.NullTime{
+	return &Time{NullTime: sql.NullTime{
 		Time:  v,
 		Valid: true,
 	}}
 }
 
-func (n Time) MarshalJSON() ([]byte, error) {
-	if !n.Valid || n.Time.IsZero() {
-		return jsonNullBytes, nil
+func TimeFrom(v time.Time) Time {
+	return *NewTime(v)
+}
+
+func TimeFromPtr(v *time.Time) Time {
+	if v == nil {
+		return Time{}
 	}
-	return n.Time.MarshalJSON()
+	return TimeFrom(*v)
 }
 
-func (n *Time) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, jsonNullBytes) || bytes.Equal(data, jsonEmptyBytes) {
-		n.Valid = false
-		return nil
+func (n Time) policy() NullPolicy {
+	return n.Policy.resolve(ZeroAsNull)
+}
+
+func (n Time) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Time)
+}
+
+// dbPolicy is the policy applied when scanning and sending values to the
+// database, where zero times are kept by default, as sql.NullTime does.
+func (n Time) dbPolicy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Time) Get() (time.Time, bool) {
+	return get(n.Time, n.valid())
+}
+
+func (n Time) ValueOr(v time.Time) time.Time {
+	return valueOr(n.Time, n.valid(), v)
+}
+
+func (n Time) MustGet() time.Time {
+	return mustGet(n.Time, n.valid())
+}
+
+func (n Time) Ptr() *time.Time {
+	return ptr(n.Time, n.valid())
+}
+
+// Scan accepts time.Time values, textual timestamps and Unix seconds, see
+// TimeScanLayouts and TimeScanLocation. MySQL zero dates are scanned as null.
+func (n *Time) Scan(value interface{}) error {
+	if isZeroDateTime(value) {
+		value = nil
 	}
-	err := n.Time.UnmarshalJSON(data)
-	if err != nil {
-		return err
+	return scanValue(value, &n.Time, &n.Valid, n.dbPolicy(), toTime)
+}
+
+func (n Time) Value() (driver.Value, error) {
+	if !n.Valid || n.dbPolicy().nulls(n.Time) {
+		return nil, nil
 	}
-	n.Valid = true
-	return nil
+	return normalizeTime(n.Time), nil
+}
+
+func (n Time) MarshalJSON() ([]byte, error) {
+	return n.marshalJSON(TimeEncoding)
+}
+
+func (n *Time) UnmarshalJSON(data []byte) error {
+	return n.unmarshalJSON(data, TimeEncoding)
+}
+
+func (n Time) MarshalText() ([]byte, error) {
+	return n.marshalText(TimeEncoding)
+}
+
+func (n *Time) UnmarshalText(text []byte) error {
+	return n.unmarshalText(text, TimeEncoding)
+}
+
+func (n Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return n.marshalXML(e, start, TimeEncoding)
+}
+
+func (n *Time) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return n.unmarshalXML(d, start, TimeEncoding)
+}
+
+func (n Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return n.marshalXMLAttr(name, TimeEncoding)
+}
+
+func (n *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return n.unmarshalXMLAttr(attr, TimeEncoding)
+}
+
+func (n Time) marshalJSON(f TimeFormat) ([]byte, error) {
+	t := normalizeTime(n.Time)
+	return marshalJSON(timeCodec{&t, f}, n.valid())
+}
+
+func (n *Time) unmarshalJSON(data []byte, f TimeFormat) error {
+	return unmarshalJSON(data, &timeCodec{&n.Time, f}, &n.Valid, n.policy())
+}
+
+func (n Time) marshalText(f TimeFormat) ([]byte, error) {
+	t := normalizeTime(n.Time)
+	return marshalText(timeCodec{&t, f}, n.valid())
+}
+
+func (n *Time) unmarshalText(text []byte, f TimeFormat) error {
+	return unmarshalText(text, &timeCodec{&n.Time, f}, &n.Valid, n.policy())
+}
+
+func (n Time) marshalXML(e *xml.Encoder, start xml.StartElement, f TimeFormat) error {
+	t := normalizeTime(n.Time)
+	return marshalXML(e, start, timeCodec{&t, f}, n.valid())
+}
+
+func (n *Time) unmarshalXML(d *xml.Decoder, start xml.StartElement, f TimeFormat) error {
+	return unmarshalXML(d, start, &timeCodec{&n.Time, f}, &n.Valid, n.policy())
+}
+
+func (n Time) marshalXMLAttr(name xml.Name, f TimeFormat) (xml.Attr, error) {
+	t := normalizeTime(n.Time)
+	return marshalXMLAttr(name, timeCodec{&t, f}, n.valid())
+}
+
+func (n *Time) unmarshalXMLAttr(attr xml.Attr, f TimeFormat) error {
+	return unmarshalXMLAttr(attr, &timeCodec{&n.Time, f}, &n.Valid, n.policy())
 }
@@ -0,0 +1,154 @@
+package nullable
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// TimeFormat defines how Time values are encoded as JSON, text and XML.
+type TimeFormat uint8
+
+const (
+	// TimeRFC3339Nano encodes times as RFC 3339 strings with nanoseconds.
+	TimeRFC3339Nano TimeFormat = iota
+	// TimeRFC3339 encodes times as RFC 3339 strings without fractional seconds.
+	TimeRFC3339
+	// TimeRFC3339Milli encodes times as RFC 3339 strings with milliseconds.
+	TimeRFC3339Milli
+	// TimeUnix encodes times as the number of seconds since the Unix epoch.
+	TimeUnix
+	// TimeUnixMilli encodes times as the number of milliseconds since the Unix
+	// epoch.
+	TimeUnixMilli
+)
+
+const rfc3339Milli = "2006-01-02T15:04:05.000Z07:00"
+
+// maxUnixExp is the binary exponent above which Unix seconds, and so
+// milliseconds, no longer fit an int64.
+const maxUnixExp = 64
+
+var (
+	// TimeEncoding is the format used to encode Time values.
+	TimeEncoding = TimeRFC3339Nano
+	// TimeLayouts are the layouts accepted when decoding Time values, tried in
+	// the given order. Numbers are only accepted with TimeUnix and
+	// TimeUnixMilli, and decoded as seconds or milliseconds since the Unix
+	// epoch.
+	TimeLayouts = []string{time.RFC3339Nano}
+	// TimeLocation, when set, is the location Time values are converted to
+	// when sent to the database and encoded.
+	TimeLocation *time.Location
+	// TimePrecision, when set, is the precision Time values are truncated to
+	// when sent to the database and encoded, such as time.Microsecond for
+	// Postgres or time.Second for MySQL DATETIME columns.
+	TimePrecision time.Duration
+	// TimeRound tells whether Time values are rounded to TimePrecision,
+	// instead of truncated.
+	TimeRound = false
+)
+
+// normalizeTime applies TimePrecision and TimeLocation to t, also stripping
+// its monotonic clock reading.
+func normalizeTime(t time.Time) time.Time {
+	t = t.Round(0)
+	if TimePrecision > 0 {
+		if TimeRound {
+			t = t.Round(TimePrecision)
+		} else {
+			t = t.Truncate(TimePrecision)
+		}
+	}
+	if TimeLocation != nil {
+		t = t.In(TimeLocation)
+	}
+	return t
+}
+
+type timeCodec struct {
+	t      *time.Time
+	format TimeFormat
+}
+
+func (c timeCodec) IsZero() bool {
+	return c.t.IsZero()
+}
+
+func (c timeCodec) MarshalText() ([]byte, error) {
+	switch c.format {
+	case TimeRFC3339:
+		return []byte(c.t.Format(time.RFC3339)), nil
+	case TimeRFC3339Milli:
+		return []byte(c.t.Format(rfc3339Milli)), nil
+	case TimeUnix:
+		return strconv.AppendInt(nil, c.t.Unix(), 10), nil
+	case TimeUnixMilli:
+		return strconv.AppendInt(nil, c.t.UnixMilli(), 10), nil
+	}
+	return []byte(c.t.Format(time.RFC3339Nano)), nil
+}
+
+func (c *timeCodec) UnmarshalText(text []byte) error {
+	s := string(text)
+	for _, layout := range TimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			*c.t = t
+			return nil
+		}
+	}
+	if c.unix() && numberRegexp.MatchString(s) {
+		return c.parseUnix(s)
+	}
+	return fmt.Errorf("nullable: cannot parse %q as time using the layouts %q", s, TimeLayouts)
+}
+
+func (c timeCodec) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil || c.unix() {
+		return text, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (c *timeCodec) UnmarshalJSON(data []byte) error {
+	if c.unix() && numberRegexp.Match(data) {
+		return c.parseUnix(string(data))
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("nullable: cannot decode %s into time: %w", data, err)
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// unix tells whether times are encoded as numbers since the Unix epoch, the
+// only formats numbers are decoded with.
+func (c timeCodec) unix() bool {
+	return c.format == TimeUnix || c.format == TimeUnixMilli
+}
+
+func (c *timeCodec) parseUnix(s string) error {
+	f, _, err := big.ParseFloat(s, 10, 256, big.ToNearestEven)
+	if err != nil {
+		return fmt.Errorf("nullable: cannot parse %q as time: %w", s, err)
+	}
+	// Values beyond 2^maxUnixExp, in seconds or milliseconds, are out of the
+	// range of time.Time, checking it before Int avoids huge allocations.
+	if f.IsInf() || f.MantExp(nil) > maxUnixExp {
+		return fmt.Errorf("%w: cannot parse %q as time", ErrOutOfRange, s)
+	}
+	unit := big.NewFloat(float64(time.Second))
+	if c.format == TimeUnixMilli {
+		unit = big.NewFloat(float64(time.Millisecond))
+	}
+	nanos, _ := f.Mul(f, unit).Int(nil)
+	sec, nsec := nanos.DivMod(nanos, big.NewInt(int64(time.Second)), new(big.Int))
+	if !sec.IsInt64() {
+		return fmt.Errorf("%w: cannot parse %q as time", ErrOutOfRange, s)
+	}
+	*c.t = time.Unix(sec.Int64(), nsec.Int64()).UTC()
+	return nil
+}
@@ -0,0 +1,111 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+type TimeOfDay struct {
+	TimeOfDay CivilTime
+	Valid     bool
+	Policy    NullPolicy
+}
+
+func NewTimeOfDay(v CivilTime) *TimeOfDay {
+	return &TimeOfDay{
+		TimeOfDay: v,
+		Valid:     true,
+	}
+}
+
+func TimeOfDayFrom(v CivilTime) TimeOfDay {
+	return *NewTimeOfDay(v)
+}
+
+func TimeOfDayFromPtr(v *CivilTime) TimeOfDay {
+	if v == nil {
+		return TimeOfDay{}
+	}
+	return TimeOfDayFrom(*v)
+}
+
+func (n TimeOfDay) policy() NullPolicy {
+	return n.Policy.resolve(EmptyAsNull)
+}
+
+func (n TimeOfDay) valid() bool {
+	return n.Valid && !n.policy().nulls(n.TimeOfDay)
+}
+
+func (n TimeOfDay) Get() (CivilTime, bool) {
+	return get(n.TimeOfDay, n.valid())
+}
+
+func (n TimeOfDay) ValueOr(v CivilTime) CivilTime {
+	return valueOr(n.TimeOfDay, n.valid(), v)
+}
+
+func (n TimeOfDay) MustGet() CivilTime {
+	return mustGet(n.TimeOfDay, n.valid())
+}
+
+func (n TimeOfDay) Ptr() *CivilTime {
+	return ptr(n.TimeOfDay, n.valid())
+}
+
+func (n *TimeOfDay) Scan(value interface{}) error {
+	return scanValue(value, &n.TimeOfDay, &n.Valid, n.policy(), toCivilTime)
+}
+
+func (n TimeOfDay) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return n.TimeOfDay.String(), nil
+}
+
+func (n TimeOfDay) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.TimeOfDay, n.valid())
+}
+
+func (n *TimeOfDay) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.TimeOfDay, &n.Valid, n.policy())
+}
+
+func (n TimeOfDay) MarshalText() ([]byte, error) {
+	return marshalText(n.TimeOfDay, n.valid())
+}
+
+func (n *TimeOfDay) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.TimeOfDay, &n.Valid, n.policy())
+}
+
+func (n TimeOfDay) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.TimeOfDay, n.valid())
+}
+
+func (n *TimeOfDay) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.TimeOfDay, &n.Valid, n.policy())
+}
+
+func (n TimeOfDay) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.TimeOfDay, n.valid())
+}
+
+func (n *TimeOfDay) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.TimeOfDay, &n.Valid, n.policy())
+}
+
+func toCivilTime(value interface{}) (CivilTime, error) {
+	switch src := value.(type) {
+	case time.Time:
+		return CivilTimeOf(src), nil
+	case string:
+		return ParseCivilTime(src)
+	case []byte:
+		return ParseCivilTime(string(src))
+	}
+	return CivilTime{}, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type TimeOfDay", value)
+}
@@ -0,0 +1,259 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var timeOfDayRef = nullable.CivilTime{Hour: 15, Minute: 4, Second: 5, Nanosecond: 123456000}
+
+func TestTimeOfDay_MarshalJSON(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should return null",
+			fields: fields{
+				value: nullable.TimeOfDay{},
+			},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name: "should return midnight",
+			fields: fields{
+				value: *nullable.NewTimeOfDay(nullable.CivilTime{}),
+			},
+			want:    []byte(`"00:00:00"`),
+			wantErr: false,
+		},
+		{
+			name: "should return the given time of day",
+			fields: fields{
+				value: *nullable.NewTimeOfDay(timeOfDayRef),
+			},
+			want:    []byte(`"15:04:05.123456"`),
+			wantErr: false,
+		},
+		{
+			name: "should marshal the given time of day from a struct",
+			fields: fields{
+				value: &struct {
+					ID    int                `json:"id"`
+					Value nullable.TimeOfDay `json:"value"`
+				}{
+					ID:    100,
+					Value: *nullable.NewTimeOfDay(nullable.CivilTime{Hour: 9}),
+				},
+			},
+			want:    []byte(`{"id":100,"value":"09:00:00"}`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeOfDay_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    nullable.TimeOfDay
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			args: args{
+				data: []byte("null"),
+			},
+			want:    nullable.TimeOfDay{},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal an empty string",
+			args: args{
+				data: []byte(`""`),
+			},
+			want:    nullable.TimeOfDay{},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal the given time of day",
+			args: args{
+				data: []byte(`"15:04:05.123456"`),
+			},
+			want:    *nullable.NewTimeOfDay(timeOfDayRef),
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal a time of day without seconds",
+			args: args{
+				data: []byte(`"15:04"`),
+			},
+			want:    *nullable.NewTimeOfDay(nullable.CivilTime{Hour: 15, Minute: 4}),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an invalid hour",
+			args: args{
+				data: []byte(`"25:00:00"`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.TimeOfDay
+			err := json.Unmarshal(tt.args.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeOfDay_Scan(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    nullable.TimeOfDay
+		wantErr bool
+	}{
+		{
+			name: "should return a null time of day",
+			fields: fields{
+				value: nil,
+			},
+			want:    nullable.TimeOfDay{},
+			wantErr: false,
+		},
+		{
+			name: "should scan midnight",
+			fields: fields{
+				value: "00:00:00",
+			},
+			want:    *nullable.NewTimeOfDay(nullable.CivilTime{}),
+			wantErr: false,
+		},
+		{
+			name: "should scan bytes",
+			fields: fields{
+				value: []byte("15:04:05.123456"),
+			},
+			want:    *nullable.NewTimeOfDay(timeOfDayRef),
+			wantErr: false,
+		},
+		{
+			name: "should scan a time",
+			fields: fields{
+				value: time.Date(0, 1, 1, 15, 4, 5, 123456000, time.UTC),
+			},
+			want:    *nullable.NewTimeOfDay(timeOfDayRef),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an unsupported format",
+			fields: fields{
+				value: int64(100),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.TimeOfDay
+			err := n.Scan(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeOfDay_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.TimeOfDay
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.TimeOfDay{},
+			want:  nil,
+		},
+		{
+			name:  "should return the given time of day as a string",
+			value: *nullable.NewTimeOfDay(timeOfDayRef),
+			want:  "15:04:05.123456",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCivilTime_Compare(t *testing.T) {
+	opening := nullable.CivilTime{Hour: 9}
+	closing := nullable.CivilTime{Hour: 18, Minute: 30}
+	if opening.Compare(closing) != -1 || closing.Compare(opening) != 1 || opening.Compare(opening) != 0 {
+		t.Errorf("Compare() got unexpected results for %v and %v", opening, closing)
+	}
+	if !opening.Before(closing) || opening.After(closing) || !closing.After(opening) {
+		t.Errorf("Before() and After() got unexpected results for %v and %v", opening, closing)
+	}
+	if got := opening.On(dateRef, time.UTC); !got.Equal(time.Date(2021, 11, 23, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("On() got = %v", got)
+	}
+}
@@ -0,0 +1,65 @@
+package nullable
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// TimeScanLayouts are the layouts tried, in the given order, when scanning
+	// Time values from textual columns. The defaults cover RFC 3339, the
+	// default output of Postgres and the formats stored by SQLite drivers.
+	TimeScanLayouts = []string{
+		time.RFC3339Nano,
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999Z07",
+		"2006-01-02 15:04:05.999999999Z07:00:00",
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02T15:04",
+		"2006-01-02 15:04",
+		time.DateOnly,
+	}
+	// TimeScanLocation is the location of the times scanned from textual
+	// columns without a time zone, as well as from Unix seconds.
+	TimeScanLocation = time.UTC
+)
+
+// toTime converts time.Time values, textual timestamps parsed using
+// TimeScanLayouts and numbers of seconds since the Unix epoch.
+func toTime(value interface{}) (time.Time, error) {
+	switch src := value.(type) {
+	case string:
+		return parseScannedTime(src)
+	case []byte:
+		return parseScannedTime(string(src))
+	case int64:
+		return time.Unix(src, 0).In(TimeScanLocation), nil
+	}
+	return convertAssign[time.Time](value)
+}
+
+func parseScannedTime(s string) (time.Time, error) {
+	for _, layout := range TimeScanLayouts {
+		if t, err := time.ParseInLocation(layout, s, TimeScanLocation); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("nullable: cannot parse %q as time using the layouts %q", s, TimeScanLayouts)
+}
+
+// isZeroDateTime tells whether value is a MySQL zero date or datetime, such
+// as "0000-00-00 00:00:00", which is scanned as null.
+func isZeroDateTime(value interface{}) bool {
+	var s string
+	switch src := value.(type) {
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return false
+	}
+	return strings.HasPrefix(s, "0000-00-00") && strings.Trim(s, "0-:. T") == ""
+}
@@ -1,6 +1,7 @@
 package nullable_test
 
 import (
+	"bytes"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -179,6 +180,14 @@ func TestTime_Scan(t *testing.T) {
 			}},
 			wantErr: false,
 		},
+		{
+			name: "should return a valid zero time",
+			fields: fields{
+				value: time.Time{},
+			},
+			want:    *nullable.NewTime(time.Time{}),
+			wantErr: false,
+		},
 		{
 			name: "should return a nullable time with the given value as its value",
 			fields: fields{
@@ -213,3 +222,400 @@ func TestTime_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestTime_ScanText(t *testing.T) {
+	saoPaulo := time.FixedZone("America/Sao_Paulo", -3*60*60)
+	tests := []struct {
+		name     string
+		layouts  []string
+		location *time.Location
+		value    interface{}
+		want     nullable.Time
+		wantErr  bool
+	}{
+		{
+			name:  "should scan a MySQL datetime",
+			value: []byte("2021-11-23 12:10:00"),
+			want:  *nullable.NewTime(timeRef),
+		},
+		{
+			name:  "should scan a SQLite timestamp",
+			value: "2021-11-23T12:10:00.000Z",
+			want:  *nullable.NewTime(timeRef),
+		},
+		{
+			name:  "should scan a SQLite timestamp with an offset",
+			value: "2021-11-23 09:10:00-03:00",
+			want:  *nullable.NewTime(time.Date(2021, 11, 23, 9, 10, 0, 0, time.FixedZone("", -3*60*60))),
+		},
+		{
+			name:  "should scan a date",
+			value: "2021-11-23",
+			want:  *nullable.NewTime(time.Date(2021, 11, 23, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:  "should scan Unix seconds",
+			value: timeRef.Unix(),
+			want:  *nullable.NewTime(timeRef),
+		},
+		{
+			name:     "should scan a datetime in the given location",
+			location: saoPaulo,
+			value:    "2021-11-23 09:10:00",
+			want:     *nullable.NewTime(time.Date(2021, 11, 23, 9, 10, 0, 0, saoPaulo)),
+		},
+		{
+			name:    "should scan using the given layouts",
+			layouts: []string{"02/01/2006 15:04"},
+			value:   "23/11/2021 12:10",
+			want:    *nullable.NewTime(timeRef),
+		},
+		{
+			name:  "should scan a MySQL zero datetime as null",
+			value: []byte("0000-00-00 00:00:00"),
+			want:  nullable.Time{},
+		},
+		{
+			name:  "should scan a MySQL zero date as null",
+			value: "0000-00-00",
+			want:  nullable.Time{},
+		},
+		{
+			name:    "should return an error due to an unknown layout",
+			value:   "23/11/2021",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(layouts []string, location *time.Location) {
+				nullable.TimeScanLayouts, nullable.TimeScanLocation = layouts, location
+			}(nullable.TimeScanLayouts, nullable.TimeScanLocation)
+			if tt.layouts != nil {
+				nullable.TimeScanLayouts = tt.layouts
+			}
+			if tt.location != nil {
+				nullable.TimeScanLocation = tt.location
+			}
+			n := nullable.Time{Policy: nullable.Strict}
+			tt.want.Policy = nullable.Strict
+			err := n.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_Normalization(t *testing.T) {
+	saoPaulo := time.FixedZone("America/Sao_Paulo", -3*60*60)
+	value := timeRef.Add(123456789 * time.Nanosecond)
+	now := time.Now()
+	tests := []struct {
+		name      string
+		location  *time.Location
+		precision time.Duration
+		round     bool
+		value     nullable.Time
+		want      time.Time
+		wantJSON  []byte
+	}{
+		{
+			name:     "should keep the time as is",
+			value:    *nullable.NewTime(value),
+			want:     value,
+			wantJSON: []byte(`"2021-11-23T12:10:00.123456789Z"`),
+		},
+		{
+			name:      "should truncate to microseconds",
+			precision: time.Microsecond,
+			value:     *nullable.NewTime(value),
+			want:      timeRef.Add(123456 * time.Microsecond),
+			wantJSON:  []byte(`"2021-11-23T12:10:00.123456Z"`),
+		},
+		{
+			name:      "should round to milliseconds",
+			precision: time.Millisecond,
+			round:     true,
+			value:     *nullable.NewTime(timeRef.Add(999500 * time.Microsecond)),
+			want:      timeRef.Add(time.Second),
+			wantJSON:  []byte(`"2021-11-23T12:10:01Z"`),
+		},
+		{
+			name:      "should convert to the given location",
+			location:  saoPaulo,
+			precision: time.Second,
+			value:     *nullable.NewTime(value),
+			want:      timeRef.In(saoPaulo),
+			wantJSON:  []byte(`"2021-11-23T09:10:00-03:00"`),
+		},
+		{
+			name:     "should convert to UTC and strip the monotonic clock",
+			location: time.UTC,
+			value:    *nullable.NewTime(now),
+			want:     now.Round(0).UTC(),
+			wantJSON: []byte(`"` + now.UTC().Format(time.RFC3339Nano) + `"`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(location *time.Location, precision time.Duration, round bool) {
+				nullable.TimeLocation, nullable.TimePrecision, nullable.TimeRound = location, precision, round
+			}(nullable.TimeLocation, nullable.TimePrecision, nullable.TimeRound)
+			nullable.TimeLocation, nullable.TimePrecision, nullable.TimeRound = tt.location, tt.precision, tt.round
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(data, tt.wantJSON) {
+				t.Errorf("MarshalJSON() got = %s, want %s", data, tt.wantJSON)
+			}
+		})
+	}
+}
+
+func TestTime_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   nullable.Time
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "should return an empty text",
+			value:   nullable.Time{},
+			want:    []byte(""),
+			wantErr: false,
+		},
+		{
+			name:    "should return an empty text for a zero time",
+			value:   *nullable.NewTime(time.Time{}),
+			want:    []byte(""),
+			wantErr: false,
+		},
+		{
+			name:    "should return the given value as text",
+			value:   *nullable.NewTime(timeRef),
+			want:    []byte("2021-11-23T12:10:00Z"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    nullable.Time
+		wantErr bool
+	}{
+		{
+			name:    "should unmarshal an empty text as null",
+			text:    []byte(""),
+			want:    nullable.Time{},
+			wantErr: false,
+		},
+		{
+			name:    "should unmarshal the given text",
+			text:    []byte("2021-11-23T12:10:00Z"),
+			want:    *nullable.NewTime(timeRef),
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			text:    []byte("23/11/2021"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Time
+			err := n.UnmarshalText(tt.text)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_TimeEncoding(t *testing.T) {
+	ref := time.Date(2021, 11, 23, 12, 10, 0, 123456789, time.UTC)
+	tests := []struct {
+		name     string
+		encoding nullable.TimeFormat
+		want     []byte
+	}{
+		{
+			name:     "should return a RFC 3339 time with nanoseconds",
+			encoding: nullable.TimeRFC3339Nano,
+			want:     []byte(`"2021-11-23T12:10:00.123456789Z"`),
+		},
+		{
+			name:     "should return a RFC 3339 time",
+			encoding: nullable.TimeRFC3339,
+			want:     []byte(`"2021-11-23T12:10:00Z"`),
+		},
+		{
+			name:     "should return a RFC 3339 time with milliseconds",
+			encoding: nullable.TimeRFC3339Milli,
+			want:     []byte(`"2021-11-23T12:10:00.123Z"`),
+		},
+		{
+			name:     "should return the seconds since the Unix epoch",
+			encoding: nullable.TimeUnix,
+			want:     []byte(`1637669400`),
+		},
+		{
+			name:     "should return the milliseconds since the Unix epoch",
+			encoding: nullable.TimeUnixMilli,
+			want:     []byte(`1637669400123`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(encoding nullable.TimeFormat) { nullable.TimeEncoding = encoding }(nullable.TimeEncoding)
+			nullable.TimeEncoding = tt.encoding
+			got, err := json.Marshal(nullable.NewTime(ref))
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+			text, err := nullable.NewTime(ref).MarshalText()
+			if err != nil {
+				t.Errorf("MarshalText() error = %v", err)
+				return
+			}
+			if want := bytes.Trim(tt.want, `"`); !reflect.DeepEqual(text, want) {
+				t.Errorf("MarshalText() got = %s, want %s", text, want)
+			}
+		})
+	}
+}
+
+func TestTime_TimeLayouts(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding nullable.TimeFormat
+		layouts  []string
+		data     []byte
+		want     time.Time
+		wantErr  bool
+	}{
+		{
+			name:    "should parse a time using the first matching layout",
+			layouts: []string{time.RFC3339, time.DateTime, time.DateOnly},
+			data:    []byte(`"2021-11-23 12:10:00"`),
+			want:    timeRef,
+		},
+		{
+			name:    "should parse a date",
+			layouts: []string{time.RFC3339, time.DateTime, time.DateOnly},
+			data:    []byte(`"2021-11-23"`),
+			want:    time.Date(2021, 11, 23, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "should return an error when no layout matches",
+			layouts: []string{time.DateOnly},
+			data:    []byte(fmt.Sprintf(`"%s"`, timeRefStr)),
+			wantErr: true,
+		},
+		{
+			name:     "should parse seconds since the Unix epoch",
+			encoding: nullable.TimeUnix,
+			layouts:  []string{time.RFC3339},
+			data:     []byte(`1637669400`),
+			want:     timeRef,
+		},
+		{
+			name:     "should parse fractional seconds since the Unix epoch",
+			encoding: nullable.TimeUnix,
+			layouts:  []string{time.RFC3339},
+			data:     []byte(`"1637669400.5"`),
+			want:     timeRef.Add(500 * time.Millisecond),
+		},
+		{
+			name:    "should return an error due to a number with a layout encoding",
+			layouts: []string{time.RFC3339},
+			data:    []byte(`1637669400`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to a quoted number with a layout encoding",
+			layouts: []string{time.RFC3339},
+			data:    []byte(`"1637669400"`),
+			wantErr: true,
+		},
+		{
+			name:     "should parse milliseconds since the Unix epoch",
+			encoding: nullable.TimeUnixMilli,
+			layouts:  []string{time.RFC3339},
+			data:     []byte(`1637669400000`),
+			want:     timeRef,
+		},
+		{
+			name:     "should return an error due to an out of range number",
+			encoding: nullable.TimeUnix,
+			layouts:  []string{time.RFC3339},
+			data:     []byte(`1e100`),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(encoding nullable.TimeFormat, layouts []string) {
+				nullable.TimeEncoding, nullable.TimeLayouts = encoding, layouts
+			}(nullable.TimeEncoding, nullable.TimeLayouts)
+			nullable.TimeEncoding, nullable.TimeLayouts = tt.encoding, tt.layouts
+			var n nullable.Time
+			err := json.Unmarshal(tt.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !n.Valid || !n.Time.Equal(tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n.Time, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+type Uint16 struct {
+	Uint16 uint16
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewUint16(v uint16) *Uint16 {
+	return &Uint16{
+		Uint16: v,
+		Valid:  true,
+	}
+}
+
+func Uint16From(v uint16) Uint16 {
+	return *NewUint16(v)
+}
+
+func Uint16FromPtr(v *uint16) Uint16 {
+	if v == nil {
+		return Uint16{}
+	}
+	return Uint16From(*v)
+}
+
+func (n Uint16) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Uint16) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Uint16)
+}
+
+func (n Uint16) Get() (uint16, bool) {
+	return get(n.Uint16, n.valid())
+}
+
+func (n Uint16) ValueOr(v uint16) uint16 {
+	return valueOr(n.Uint16, n.valid(), v)
+}
+
+func (n Uint16) MustGet() uint16 {
+	return mustGet(n.Uint16, n.valid())
+}
+
+func (n Uint16) Ptr() *uint16 {
+	return ptr(n.Uint16, n.valid())
+}
+
+func (n *Uint16) Scan(value interface{}) error {
+	return scanUint(value, &n.Uint16, &n.Valid, n.policy(), 16)
+}
+
+func (n Uint16) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return int64(n.Uint16), nil
+}
+
+func (n Uint16) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Uint16, n.valid())
+}
+
+func (n *Uint16) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Uint16, &n.Valid, n.policy())
+}
+
+func (n Uint16) MarshalText() ([]byte, error) {
+	return marshalText(n.Uint16, n.valid())
+}
+
+func (n *Uint16) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Uint16, &n.Valid, n.policy())
+}
+
+func (n Uint16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Uint16, n.valid())
+}
+
+func (n *Uint16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Uint16, &n.Valid, n.policy())
+}
+
+func (n Uint16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Uint16, n.valid())
+}
+
+func (n *Uint16) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Uint16, &n.Valid, n.policy())
+}
@@ -0,0 +1,359 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/diegohordi/nullable"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestUint16_MarshalJSON(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should return null",
+			fields: fields{
+				value: nullable.Uint16{
+					Uint16: 0,
+					Valid:  false,
+				}},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name: "should return the given uint16",
+			fields: fields{
+				value: *nullable.NewUint16(math.MaxUint16),
+			},
+			want:    []byte(fmt.Sprintf("%v", uint64(math.MaxUint16))),
+			wantErr: false,
+		},
+		{
+			name: "should marshal the given uint16 from a struct",
+			fields: fields{
+				value: &struct {
+					ID    int             `json:"id"`
+					Value nullable.Uint16 `json:"value"`
+				}{
+					ID:    100,
+					Value: *nullable.NewUint16(100),
+				},
+			},
+			want:    []byte(`{"id":100,"value":100}`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUint16_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		holder  interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			args: args{
+				data: []byte("null"),
+			},
+			holder: &nullable.Uint16{},
+			want: &nullable.Uint16{
+				Uint16: 0,
+				Valid:  false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal into a struct",
+			args: args{
+				data: []byte(`{"id": 100, "value":120}`),
+			},
+			holder: &struct {
+				ID    int             `json:"id"`
+				Value nullable.Uint16 `json:"value"`
+			}{},
+			want: &struct {
+				ID    int             `json:"id"`
+				Value nullable.Uint16 `json:"value"`
+			}{
+				ID:    100,
+				Value: *nullable.NewUint16(120),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to a negative value",
+			args: args{
+				data: []byte(`{"id": 100, "value":-1}`),
+			},
+			holder: &struct {
+				ID    int             `json:"id"`
+				Value nullable.Uint16 `json:"value"`
+			}{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an unexpected value",
+			args: args{
+				data: []byte(`{"id": 100, "value":"test"}`),
+			},
+			holder: &struct {
+				ID    int             `json:"id"`
+				Value nullable.Uint16 `json:"value"`
+			}{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := json.Unmarshal(tt.args.data, tt.holder)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.holder, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", tt.holder, tt.want)
+			}
+		})
+	}
+}
+
+func TestUint16_Scan(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    nullable.Uint16
+		wantErr bool
+	}{
+		{
+			name: "should return a nullable uint16 with 0 as value",
+			fields: fields{
+				value: nil,
+			},
+			want: nullable.Uint16{
+				Uint16: 0,
+				Valid:  false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable uint16 with the given int64 as its value",
+			fields: fields{
+				value: int64(100),
+			},
+			want:    *nullable.NewUint16(100),
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable uint16 with the given bytes as its value",
+			fields: fields{
+				value: []byte(fmt.Sprintf("%v", uint64(math.MaxUint16))),
+			},
+			want:    *nullable.NewUint16(math.MaxUint16),
+			wantErr: false,
+		},
+		{
+			name: "should return a nullable uint16 with the given string as its value",
+			fields: fields{
+				value: "100",
+			},
+			want:    *nullable.NewUint16(100),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an overflow",
+			fields: fields{
+				value: "65536",
+			},
+			want:    nullable.Uint16{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to a negative value",
+			fields: fields{
+				value: int64(-1),
+			},
+			want:    nullable.Uint16{},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an unsupported format",
+			fields: fields{
+				value: make(chan string),
+			},
+			want:    nullable.Uint16{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Uint16
+			err := n.Scan(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestUint16_Value(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   driver.Valuer
+		want    driver.Value
+		wantErr bool
+	}{
+		{
+			name:    "should return nil",
+			value:   nullable.Uint16{},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "should return the given uint16 as int64",
+			value:   *nullable.NewUint16(100),
+			want:    int64(100),
+			wantErr: false,
+		},
+		{
+			name:    "should return the max uint16 as int64",
+			value:   *nullable.NewUint16(math.MaxUint16),
+			want:    int64(math.MaxUint16),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUint16_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   nullable.Uint16
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "should return an empty text",
+			value:   nullable.Uint16{},
+			want:    []byte(""),
+			wantErr: false,
+		},
+		{
+			name:    "should return the given value as text",
+			value:   *nullable.NewUint16(100),
+			want:    []byte("100"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUint16_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    nullable.Uint16
+		wantErr bool
+	}{
+		{
+			name:    "should unmarshal an empty text as null",
+			text:    []byte(""),
+			want:    nullable.Uint16{},
+			wantErr: false,
+		},
+		{
+			name:    "should unmarshal the given text",
+			text:    []byte("100"),
+			want:    *nullable.NewUint16(100),
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to an unexpected value",
+			text:    []byte("-1"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.Uint16
+			err := n.UnmarshalText(tt.text)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalText() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+type Uint32 struct {
+	Uint32 uint32
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewUint32(v uint32) *Uint32 {
+	return &Uint32{
+		Uint32: v,
+		Valid:  true,
+	}
+}
+
+func Uint32From(v uint32) Uint32 {
+	return *NewUint32(v)
+}
+
+func Uint32FromPtr(v *uint32) Uint32 {
+	if v == nil {
+		return Uint32{}
+	}
+	return Uint32From(*v)
+}
+
+func (n Uint32) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Uint32) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Uint32)
+}
+
+func (n Uint32) Get() (uint32, bool) {
+	return get(n.Uint32, n.valid())
+}
+
+func (n Uint32) ValueOr(v uint32) uint32 {
+	return valueOr(n.Uint32, n.valid(), v)
+}
+
+func (n Uint32) MustGet() uint32 {
+	return mustGet(n.Uint32, n.valid())
+}
+
+func (n Uint32) Ptr() *uint32 {
+	return ptr(n.Uint32, n.valid())
+}
+
+func (n *Uint32) Scan(value interface{}) error {
+	return scanUint(value, &n.Uint32, &n.Valid, n.policy(), 32)
+}
+
+func (n Uint32) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return int64(n.Uint32), nil
+}
+
+func (n Uint32) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Uint32, n.valid())
+}
+
+func (n *Uint32) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Uint32, &n.Valid, n.policy())
+}
+
+func (n Uint32) MarshalText() ([]byte, error) {
+	return marshalText(n.Uint32, n.valid())
+}
+
+func (n *Uint32) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Uint32, &n.Valid, n.policy())
+}
+
+func (n Uint32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Uint32, n.valid())
+}
+
+func (n *Uint32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Uint32, &n.Valid, n.policy())
+}
+
+func (n Uint32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Uint32, n.valid())
+}
+
+func (n *Uint32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Uint32, &n.Valid, n.policy())
+}
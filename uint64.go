@@ -0,0 +1,109 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"math"
+	"strconv"
+)
+
+// Uint64ValueFallback converts the values greater than math.MaxInt64, which are
+// not supported by database/sql, into a driver.Value. By default they are sent
+// as decimal strings.
+var Uint64ValueFallback = func(v uint64) (driver.Value, error) {
+	return strconv.FormatUint(v, 10), nil
+}
+
+type Uint64 struct {
+	Uint64 uint64
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewUint64(v uint64) *Uint64 {
+	return &Uint64{
+		Uint64: v,
+		Valid:  true,
+	}
+}
+
+func Uint64From(v uint64) Uint64 {
+	return *NewUint64(v)
+}
+
+func Uint64FromPtr(v *uint64) Uint64 {
+	if v == nil {
+		return Uint64{}
+	}
+	return Uint64From(*v)
+}
+
+func (n Uint64) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Uint64) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Uint64)
+}
+
+func (n Uint64) Get() (uint64, bool) {
+	return get(n.Uint64, n.valid())
+}
+
+func (n Uint64) ValueOr(v uint64) uint64 {
+	return valueOr(n.Uint64, n.valid(), v)
+}
+
+func (n Uint64) MustGet() uint64 {
+	return mustGet(n.Uint64, n.valid())
+}
+
+func (n Uint64) Ptr() *uint64 {
+	return ptr(n.Uint64, n.valid())
+}
+
+func (n *Uint64) Scan(value interface{}) error {
+	return scanUint(value, &n.Uint64, &n.Valid, n.policy(), 64)
+}
+
+func (n Uint64) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	if n.Uint64 > math.MaxInt64 {
+		return Uint64ValueFallback(n.Uint64)
+	}
+	return int64(n.Uint64), nil
+}
+
+func (n Uint64) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Uint64, n.valid())
+}
+
+func (n *Uint64) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Uint64, &n.Valid, n.policy())
+}
+
+func (n Uint64) MarshalText() ([]byte, error) {
+	return marshalText(n.Uint64, n.valid())
+}
+
+func (n *Uint64) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Uint64, &n.Valid, n.policy())
+}
+
+func (n Uint64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Uint64, n.valid())
+}
+
+func (n *Uint64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Uint64, &n.Valid, n.policy())
+}
+
+func (n Uint64) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Uint64, n.valid())
+}
+
+func (n *Uint64) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Uint64, &n.Valid, n.policy())
+}
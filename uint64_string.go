@@ -0,0 +1,32 @@
+package nullable
+
+// Uint64String is a Uint64 encoded as a JSON string, so that values beyond
+// 2^53 are not corrupted by JavaScript clients. Both strings and numbers are
+// accepted when decoding. Scan, Value and the text and XML encodings are the
+// same of Uint64.
+type Uint64String struct {
+	Uint64
+}
+
+func NewUint64String(v uint64) *Uint64String {
+	return &Uint64String{*NewUint64(v)}
+}
+
+func Uint64StringFrom(v uint64) Uint64String {
+	return *NewUint64String(v)
+}
+
+func Uint64StringFromPtr(v *uint64) Uint64String {
+	if v == nil {
+		return Uint64String{}
+	}
+	return Uint64StringFrom(*v)
+}
+
+func (n Uint64String) MarshalJSON() ([]byte, error) {
+	return marshalJSON(jsonString[uint64]{&n.Uint64.Uint64}, n.valid())
+}
+
+func (n *Uint64String) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &jsonString[uint64]{&n.Uint64.Uint64}, &n.Valid, n.policy())
+}
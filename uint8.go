@@ -0,0 +1,97 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+type Uint8 struct {
+	Uint8  uint8
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewUint8(v uint8) *Uint8 {
+	return &Uint8{
+		Uint8: v,
+		Valid: true,
+	}
+}
+
+func Uint8From(v uint8) Uint8 {
+	return *NewUint8(v)
+}
+
+func Uint8FromPtr(v *uint8) Uint8 {
+	if v == nil {
+		return Uint8{}
+	}
+	return Uint8From(*v)
+}
+
+func (n Uint8) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n Uint8) valid() bool {
+	return n.Valid && !n.policy().nulls(n.Uint8)
+}
+
+func (n Uint8) Get() (uint8, bool) {
+	return get(n.Uint8, n.valid())
+}
+
+func (n Uint8) ValueOr(v uint8) uint8 {
+	return valueOr(n.Uint8, n.valid(), v)
+}
+
+func (n Uint8) MustGet() uint8 {
+	return mustGet(n.Uint8, n.valid())
+}
+
+func (n Uint8) Ptr() *uint8 {
+	return ptr(n.Uint8, n.valid())
+}
+
+func (n *Uint8) Scan(value interface{}) error {
+	return scanUint(value, &n.Uint8, &n.Valid, n.policy(), 8)
+}
+
+func (n Uint8) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return int64(n.Uint8), nil
+}
+
+func (n Uint8) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Uint8, n.valid())
+}
+
+func (n *Uint8) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &n.Uint8, &n.Valid, n.policy())
+}
+
+func (n Uint8) MarshalText() ([]byte, error) {
+	return marshalText(n.Uint8, n.valid())
+}
+
+func (n *Uint8) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &n.Uint8, &n.Valid, n.policy())
+}
+
+func (n Uint8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.Uint8, n.valid())
+}
+
+func (n *Uint8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &n.Uint8, &n.Valid, n.policy())
+}
+
+func (n Uint8) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.Uint8, n.valid())
+}
+
+func (n *Uint8) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &n.Uint8, &n.Valid, n.policy())
+}
@@ -0,0 +1,59 @@
+package nullable
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// UnixMilliTime is a Time encoded as the number of milliseconds since the Unix
+// epoch, regardless of TimeEncoding.
+type UnixMilliTime struct {
+	Time
+}
+
+func NewUnixMilliTime(v time.Time) *UnixMilliTime {
+	return &UnixMilliTime{*NewTime(v)}
+}
+
+func UnixMilliTimeFrom(v time.Time) UnixMilliTime {
+	return *NewUnixMilliTime(v)
+}
+
+func UnixMilliTimeFromPtr(v *time.Time) UnixMilliTime {
+	if v == nil {
+		return UnixMilliTime{}
+	}
+	return UnixMilliTimeFrom(*v)
+}
+
+func (n UnixMilliTime) MarshalJSON() ([]byte, error) {
+	return n.marshalJSON(TimeUnixMilli)
+}
+
+func (n *UnixMilliTime) UnmarshalJSON(data []byte) error {
+	return n.unmarshalJSON(data, TimeUnixMilli)
+}
+
+func (n UnixMilliTime) MarshalText() ([]byte, error) {
+	return n.marshalText(TimeUnixMilli)
+}
+
+func (n *UnixMilliTime) UnmarshalText(text []byte) error {
+	return n.unmarshalText(text, TimeUnixMilli)
+}
+
+func (n UnixMilliTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return n.marshalXML(e, start, TimeUnixMilli)
+}
+
+func (n *UnixMilliTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return n.unmarshalXML(d, start, TimeUnixMilli)
+}
+
+func (n UnixMilliTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return n.marshalXMLAttr(name, TimeUnixMilli)
+}
+
+func (n *UnixMilliTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return n.unmarshalXMLAttr(attr, TimeUnixMilli)
+}
@@ -0,0 +1,59 @@
+package nullable
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// UnixTime is a Time encoded as the number of seconds since the Unix epoch,
+// regardless of TimeEncoding.
+type UnixTime struct {
+	Time
+}
+
+func NewUnixTime(v time.Time) *UnixTime {
+	return &UnixTime{*NewTime(v)}
+}
+
+func UnixTimeFrom(v time.Time) UnixTime {
+	return *NewUnixTime(v)
+}
+
+func UnixTimeFromPtr(v *time.Time) UnixTime {
+	if v == nil {
+		return UnixTime{}
+	}
+	return UnixTimeFrom(*v)
+}
+
+func (n UnixTime) MarshalJSON() ([]byte, error) {
+	return n.marshalJSON(TimeUnix)
+}
+
+func (n *UnixTime) UnmarshalJSON(data []byte) error {
+	return n.unmarshalJSON(data, TimeUnix)
+}
+
+func (n UnixTime) MarshalText() ([]byte, error) {
+	return n.marshalText(TimeUnix)
+}
+
+func (n *UnixTime) UnmarshalText(text []byte) error {
+	return n.unmarshalText(text, TimeUnix)
+}
+
+func (n UnixTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return n.marshalXML(e, start, TimeUnix)
+}
+
+func (n *UnixTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return n.unmarshalXML(d, start, TimeUnix)
+}
+
+func (n UnixTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return n.marshalXMLAttr(name, TimeUnix)
+}
+
+func (n *UnixTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return n.unmarshalXMLAttr(attr, TimeUnix)
+}
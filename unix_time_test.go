@@ -0,0 +1,162 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUnixTime_MarshalJSON(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should return null",
+			fields: fields{
+				value: nullable.UnixTime{},
+			},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name: "should return the given time as a number",
+			fields: fields{
+				value: *nullable.NewUnixTime(timeRef),
+			},
+			want:    []byte("1637669400"),
+			wantErr: false,
+		},
+		{
+			name: "should marshal the given time from a struct",
+			fields: fields{
+				value: &struct {
+					ID    int               `json:"id"`
+					Value nullable.UnixTime `json:"value"`
+				}{
+					ID:    100,
+					Value: *nullable.NewUnixTime(timeRef),
+				},
+			},
+			want:    []byte(`{"id":100,"value":1637669400}`),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnixTime_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    nullable.UnixTime
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			args: args{
+				data: []byte("null"),
+			},
+			want:    nullable.UnixTime{},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal a number",
+			args: args{
+				data: []byte("1637669400"),
+			},
+			want:    *nullable.NewUnixTime(timeRef),
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal a RFC 3339 time",
+			args: args{
+				data: []byte(`"` + timeRefStr + `"`),
+			},
+			want:    *nullable.NewUnixTime(timeRef),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an unexpected value",
+			args: args{
+				data: []byte("false"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an infinite number",
+			args: args{
+				data: []byte("1e1000000000"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an infinite quoted number",
+			args: args{
+				data: []byte(`"1e1000000000"`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to a huge number",
+			args: args{
+				data: []byte("1e300000000"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.UnixTime
+			err := json.Unmarshal(tt.args.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if n.Valid != tt.want.Valid || !n.Time.Time.Equal(tt.want.Time.Time) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnixTime_MarshalText(t *testing.T) {
+	got, err := nullable.NewUnixTime(timeRef).MarshalText()
+	if err != nil || string(got) != "1637669400" {
+		t.Errorf("MarshalText() got = %s, error = %v", got, err)
+	}
+	var n nullable.UnixTime
+	if err := n.UnmarshalText(got); err != nil || !n.Time.Time.Equal(timeRef) {
+		t.Errorf("UnmarshalText() got = %v, error = %v", n, err)
+	}
+	if !n.Time.Time.Equal(time.Unix(1637669400, 0)) {
+		t.Errorf("UnmarshalText() got = %v", n)
+	}
+}
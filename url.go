@@ -0,0 +1,176 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+var (
+	// URLRequireAbsolute tells whether URLs without a scheme are rejected when
+	// decoding and scanning URL values.
+	URLRequireAbsolute = false
+	// URLSchemes lists the schemes accepted when decoding and scanning URL
+	// values, such as "https". Any scheme is accepted when it is empty.
+	URLSchemes []string
+)
+
+// URL is a nullable URL, validated when decoded or scanned and encoded in its
+// normalized form, with lowercase scheme and host.
+type URL struct {
+	URL    url.URL
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewURL(v url.URL) *URL {
+	return &URL{
+		URL:   v,
+		Valid: true,
+	}
+}
+
+func URLFrom(v url.URL) URL {
+	return *NewURL(v)
+}
+
+func URLFromPtr(v *url.URL) URL {
+	if v == nil {
+		return URL{}
+	}
+	return URLFrom(*v)
+}
+
+// ParseURL parses and validates s according to URLRequireAbsolute and
+// URLSchemes.
+func ParseURL(s string) (URL, error) {
+	v, err := parseURL(s)
+	if err != nil {
+		return URL{}, err
+	}
+	return URLFrom(v), nil
+}
+
+func (n URL) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n URL) valid() bool {
+	return n.Valid && !n.policy().nulls(n.URL)
+}
+
+func (n URL) Get() (url.URL, bool) {
+	return get(n.URL, n.valid())
+}
+
+func (n URL) ValueOr(v url.URL) url.URL {
+	return valueOr(n.URL, n.valid(), v)
+}
+
+func (n URL) MustGet() url.URL {
+	return mustGet(n.URL, n.valid())
+}
+
+func (n URL) Ptr() *url.URL {
+	return ptr(n.URL, n.valid())
+}
+
+func (n URL) String() string {
+	return n.URL.String()
+}
+
+func (n *URL) Scan(value interface{}) error {
+	return scanValue(value, &n.URL, &n.Valid, n.policy(), toURL)
+}
+
+func (n URL) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	return n.URL.String(), nil
+}
+
+func (n URL) MarshalJSON() ([]byte, error) {
+	return marshalJSON(urlText(n.URL), n.valid())
+}
+
+func (n *URL) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, (*urlText)(&n.URL), &n.Valid, n.policy())
+}
+
+func (n URL) MarshalText() ([]byte, error) {
+	return marshalText(urlText(n.URL), n.valid())
+}
+
+func (n *URL) UnmarshalText(text []byte) error {
+	return unmarshalText(text, (*urlText)(&n.URL), &n.Valid, n.policy())
+}
+
+func (n URL) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, urlText(n.URL), n.valid())
+}
+
+func (n *URL) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, (*urlText)(&n.URL), &n.Valid, n.policy())
+}
+
+func (n URL) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, urlText(n.URL), n.valid())
+}
+
+func (n *URL) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, (*urlText)(&n.URL), &n.Valid, n.policy())
+}
+
+type urlText url.URL
+
+func (u urlText) IsZero() bool {
+	return u == urlText{}
+}
+
+func (u urlText) MarshalText() ([]byte, error) {
+	v := url.URL(u)
+	return []byte(v.String()), nil
+}
+
+func (u *urlText) UnmarshalText(text []byte) error {
+	v, err := parseURL(string(text))
+	if err != nil {
+		return err
+	}
+	*u = urlText(v)
+	return nil
+}
+
+func toURL(value interface{}) (url.URL, error) {
+	switch src := value.(type) {
+	case string:
+		return parseURL(src)
+	case []byte:
+		return parseURL(string(src))
+	}
+	return url.URL{}, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type URL", value)
+}
+
+func parseURL(s string) (url.URL, error) {
+	if s == "" {
+		return url.URL{}, fmt.Errorf("nullable: invalid URL %q: empty URL", s)
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return url.URL{}, fmt.Errorf("nullable: invalid URL %q: %w", s, err)
+	}
+	if URLRequireAbsolute && !u.IsAbs() {
+		return url.URL{}, fmt.Errorf("nullable: invalid URL %q: not absolute", s)
+	}
+	if len(URLSchemes) > 0 && !slices.ContainsFunc(URLSchemes, func(scheme string) bool {
+		return strings.EqualFold(scheme, u.Scheme)
+	}) {
+		return url.URL{}, fmt.Errorf("nullable: invalid URL %q: scheme not allowed", s)
+	}
+	u.Host = strings.ToLower(u.Host)
+	return *u, nil
+}
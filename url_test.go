@@ -0,0 +1,217 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/diegohordi/nullable"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func mustParseURL(s string) url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return *u
+}
+
+func TestURL_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []byte
+	}{
+		{
+			name:  "should return null",
+			value: nullable.URL{},
+			want:  []byte("null"),
+		},
+		{
+			name:  "should return the URL",
+			value: *nullable.NewURL(mustParseURL("https://example.com/hooks?id=1")),
+			want:  []byte(`"https://example.com/hooks?id=1"`),
+		},
+		{
+			name: "should marshal the given URL from a struct",
+			value: &struct {
+				ID    int          `json:"id"`
+				Value nullable.URL `json:"value"`
+			}{
+				ID:    100,
+				Value: *nullable.NewURL(mustParseURL("/avatars/100.png")),
+			},
+			want: []byte(`{"id":100,"value":"/avatars/100.png"}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURL_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		absolute bool
+		schemes  []string
+		data     []byte
+		want     nullable.URL
+		wantErr  bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			data: []byte("null"),
+			want: nullable.URL{},
+		},
+		{
+			name: "should unmarshal a normalized URL",
+			data: []byte(`"HTTPS://Example.COM/Hooks"`),
+			want: *nullable.NewURL(mustParseURL("https://example.com/Hooks")),
+		},
+		{
+			name: "should unmarshal a relative URL",
+			data: []byte(`"/avatars/100.png"`),
+			want: *nullable.NewURL(mustParseURL("/avatars/100.png")),
+		},
+		{
+			name:     "should return an error due to a relative URL",
+			absolute: true,
+			data:     []byte(`"/avatars/100.png"`),
+			wantErr:  true,
+		},
+		{
+			name:    "should unmarshal an allowed scheme",
+			schemes: []string{"http", "https"},
+			data:    []byte(`"https://example.com"`),
+			want:    *nullable.NewURL(mustParseURL("https://example.com")),
+		},
+		{
+			name:    "should return an error due to a scheme not allowed",
+			schemes: []string{"https"},
+			data:    []byte(`"javascript:alert(1)"`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an invalid URL",
+			data:    []byte(`"https://exa mple.com"`),
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an empty URL",
+			data:    []byte(`""`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(absolute bool, schemes []string) {
+				nullable.URLRequireAbsolute, nullable.URLSchemes = absolute, schemes
+			}(nullable.URLRequireAbsolute, nullable.URLSchemes)
+			nullable.URLRequireAbsolute, nullable.URLSchemes = tt.absolute, tt.schemes
+			var n nullable.URL
+			err := json.Unmarshal(tt.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestURL_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    nullable.URL
+		wantErr bool
+	}{
+		{
+			name:  "should return a null URL",
+			value: nil,
+			want:  nullable.URL{},
+		},
+		{
+			name:  "should scan a string",
+			value: "https://example.com/hooks",
+			want:  *nullable.NewURL(mustParseURL("https://example.com/hooks")),
+		},
+		{
+			name:  "should scan bytes",
+			value: []byte("https://example.com/hooks"),
+			want:  *nullable.NewURL(mustParseURL("https://example.com/hooks")),
+		},
+		{
+			name:    "should return an error due to an invalid URL",
+			value:   "%zz",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unsupported type",
+			value:   int64(1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.URL
+			err := n.Scan(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestURL_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value nullable.URL
+		want  driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.URL{},
+			want:  nil,
+		},
+		{
+			name:  "should return the URL",
+			value: *nullable.NewURL(mustParseURL("https://example.com/a b")),
+			want:  "https://example.com/a%20b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// UUIDBinaryValue tells whether UUID values are sent to the database as 16
+// bytes, as expected by BINARY(16) columns, instead of their canonical text.
+var UUIDBinaryValue = false
+
+type UUID struct {
+	UUID   [16]byte
+	Valid  bool
+	Policy NullPolicy
+}
+
+func NewUUID(v [16]byte) *UUID {
+	return &UUID{
+		UUID:  v,
+		Valid: true,
+	}
+}
+
+func UUIDFrom(v [16]byte) UUID {
+	return *NewUUID(v)
+}
+
+func UUIDFromPtr(v *[16]byte) UUID {
+	if v == nil {
+		return UUID{}
+	}
+	return UUIDFrom(*v)
+}
+
+// ParseUUID parses the canonical, braced and URN forms of an UUID, as well as
+// its 32 hexadecimal digits without hyphens.
+func ParseUUID(s string) (UUID, error) {
+	v, err := parseUUID(s)
+	if err != nil {
+		return UUID{}, err
+	}
+	return UUIDFrom(v), nil
+}
+
+func (n UUID) policy() NullPolicy {
+	return n.Policy.resolve(Strict)
+}
+
+func (n UUID) valid() bool {
+	return n.Valid && !n.policy().nulls(n.UUID)
+}
+
+func (n UUID) Get() ([16]byte, bool) {
+	return get(n.UUID, n.valid())
+}
+
+func (n UUID) ValueOr(v [16]byte) [16]byte {
+	return valueOr(n.UUID, n.valid(), v)
+}
+
+func (n UUID) MustGet() [16]byte {
+	return mustGet(n.UUID, n.valid())
+}
+
+func (n UUID) Ptr() *[16]byte {
+	return ptr(n.UUID, n.valid())
+}
+
+// String returns the canonical form of the UUID, or an empty string when null.
+func (n UUID) String() string {
+	if !n.valid() {
+		return ""
+	}
+	return uuidText(n.UUID).String()
+}
+
+func (n *UUID) Scan(value interface{}) error {
+	return scanValue(value, &n.UUID, &n.Valid, n.policy(), toUUID)
+}
+
+func (n UUID) Value() (driver.Value, error) {
+	if !n.valid() {
+		return nil, nil
+	}
+	if UUIDBinaryValue {
+		return n.UUID[:], nil
+	}
+	return uuidText(n.UUID).String(), nil
+}
+
+func (n UUID) MarshalJSON() ([]byte, error) {
+	return marshalJSON(uuidText(n.UUID), n.valid())
+}
+
+func (n *UUID) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, (*uuidText)(&n.UUID), &n.Valid, n.policy())
+}
+
+func (n UUID) MarshalText() ([]byte, error) {
+	return marshalText(uuidText(n.UUID), n.valid())
+}
+
+func (n *UUID) UnmarshalText(text []byte) error {
+	return unmarshalText(text, (*uuidText)(&n.UUID), &n.Valid, n.policy())
+}
+
+func (n UUID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, uuidText(n.UUID), n.valid())
+}
+
+func (n *UUID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, (*uuidText)(&n.UUID), &n.Valid, n.policy())
+}
+
+func (n UUID) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, uuidText(n.UUID), n.valid())
+}
+
+func (n *UUID) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, (*uuidText)(&n.UUID), &n.Valid, n.policy())
+}
+
+type uuidText [16]byte
+
+func (u uuidText) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+func (u uuidText) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *uuidText) UnmarshalText(text []byte) error {
+	v, err := parseUUID(string(text))
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
+
+func toUUID(value interface{}) ([16]byte, error) {
+	switch src := value.(type) {
+	case []byte:
+		if len(src) == 16 {
+			var u [16]byte
+			copy(u[:], src)
+			return u, nil
+		}
+		return parseUUID(string(src))
+	case string:
+		return parseUUID(src)
+	}
+	return [16]byte{}, fmt.Errorf("nullable: unsupported Scan, storing driver.Value type %T into type UUID", value)
+}
+
+func parseUUID(s string) ([16]byte, error) {
+	var u [16]byte
+	text := s
+	switch {
+	case len(text) == 45 && strings.EqualFold(text[:9], "urn:uuid:"):
+		text = text[9:]
+	case len(text) == 38 && text[0] == '{' && text[37] == '}':
+		text = text[1:37]
+	}
+	switch len(text) {
+	case 36:
+		if text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+			return u, fmt.Errorf("nullable: invalid UUID %q", s)
+		}
+		text = text[0:8] + text[9:13] + text[14:18] + text[19:23] + text[24:]
+	case 32:
+	default:
+		return u, fmt.Errorf("nullable: invalid UUID %q", s)
+	}
+	if _, err := hex.Decode(u[:], []byte(text)); err != nil {
+		return u, fmt.Errorf("nullable: invalid UUID %q", s)
+	}
+	return u, nil
+}
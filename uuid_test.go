@@ -0,0 +1,288 @@
+package nullable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"testing"
+)
+
+var (
+	uuidRef    = [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	uuidRefStr = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+)
+
+func TestUUID_MarshalJSON(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should return null",
+			fields: fields{
+				value: nullable.UUID{},
+			},
+			want:    []byte("null"),
+			wantErr: false,
+		},
+		{
+			name: "should return the given uuid",
+			fields: fields{
+				value: *nullable.NewUUID(uuidRef),
+			},
+			want:    []byte(fmt.Sprintf(`"%s"`, uuidRefStr)),
+			wantErr: false,
+		},
+		{
+			name: "should marshal the given uuid from a struct",
+			fields: fields{
+				value: &struct {
+					ID    int           `json:"id"`
+					Value nullable.UUID `json:"value"`
+				}{
+					ID:    100,
+					Value: *nullable.NewUUID(uuidRef),
+				},
+			},
+			want:    []byte(fmt.Sprintf(`{"id":100,"value":"%s"}`, uuidRefStr)),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUUID_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    nullable.UUID
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal a null value",
+			args: args{
+				data: []byte("null"),
+			},
+			want:    nullable.UUID{},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal the canonical form",
+			args: args{
+				data: []byte(`"6BA7B810-9DAD-11D1-80B4-00C04FD430C8"`),
+			},
+			want:    *nullable.NewUUID(uuidRef),
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal the braced form",
+			args: args{
+				data: []byte(fmt.Sprintf(`"{%s}"`, uuidRefStr)),
+			},
+			want:    *nullable.NewUUID(uuidRef),
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal the URN form",
+			args: args{
+				data: []byte(fmt.Sprintf(`"urn:uuid:%s"`, uuidRefStr)),
+			},
+			want:    *nullable.NewUUID(uuidRef),
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal the hexadecimal form",
+			args: args{
+				data: []byte(`"6ba7b8109dad11d180b400c04fd430c8"`),
+			},
+			want:    *nullable.NewUUID(uuidRef),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to misplaced hyphens",
+			args: args{
+				data: []byte(`"6ba7b8109-dad-11d1-80b4-00c04fd430c8"`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error due to an unexpected value",
+			args: args{
+				data: []byte(`"test"`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.UUID
+			err := json.Unmarshal(tt.args.data, &n)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestUUID_Scan(t *testing.T) {
+	type fields struct {
+		value interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    nullable.UUID
+		wantErr bool
+	}{
+		{
+			name: "should return a null uuid",
+			fields: fields{
+				value: nil,
+			},
+			want:    nullable.UUID{},
+			wantErr: false,
+		},
+		{
+			name: "should scan the binary form",
+			fields: fields{
+				value: uuidRef[:],
+			},
+			want:    *nullable.NewUUID(uuidRef),
+			wantErr: false,
+		},
+		{
+			name: "should scan the textual form",
+			fields: fields{
+				value: uuidRefStr,
+			},
+			want:    *nullable.NewUUID(uuidRef),
+			wantErr: false,
+		},
+		{
+			name: "should scan the textual form as bytes",
+			fields: fields{
+				value: []byte(uuidRefStr),
+			},
+			want:    *nullable.NewUUID(uuidRef),
+			wantErr: false,
+		},
+		{
+			name: "should return an error due to an unsupported format",
+			fields: fields{
+				value: int64(100),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n nullable.UUID
+			err := n.Scan(tt.fields.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(n, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestUUID_Value(t *testing.T) {
+	tests := []struct {
+		name   string
+		binary bool
+		value  nullable.UUID
+		want   driver.Value
+	}{
+		{
+			name:  "should return nil",
+			value: nullable.UUID{},
+			want:  nil,
+		},
+		{
+			name:  "should return the canonical form",
+			value: *nullable.NewUUID(uuidRef),
+			want:  uuidRefStr,
+		},
+		{
+			name:   "should return the binary form",
+			binary: true,
+			value:  *nullable.NewUUID(uuidRef),
+			want:   uuidRef[:],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(binary bool) { nullable.UUIDBinaryValue = binary }(nullable.UUIDBinaryValue)
+			nullable.UUIDBinaryValue = tt.binary
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUUID_Text(t *testing.T) {
+	n, err := nullable.ParseUUID(uuidRefStr)
+	if err != nil {
+		t.Fatalf("ParseUUID() error = %v", err)
+	}
+	if n.String() != uuidRefStr {
+		t.Errorf("String() got = %s, want %s", n.String(), uuidRefStr)
+	}
+	text, err := n.MarshalText()
+	if err != nil || string(text) != uuidRefStr {
+		t.Errorf("MarshalText() got = %s, error = %v", text, err)
+	}
+	var got nullable.UUID
+	if err := got.UnmarshalText(text); err != nil || !reflect.DeepEqual(got, n) {
+		t.Errorf("UnmarshalText() got = %v, error = %v", got, err)
+	}
+	if err := got.UnmarshalText(nil); err != nil || got.Valid || got.String() != "" {
+		t.Errorf("UnmarshalText() got = %v, error = %v", got, err)
+	}
+	if _, err := nullable.ParseUUID("test"); err == nil {
+		t.Errorf("ParseUUID() expected an error")
+	}
+}
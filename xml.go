@@ -0,0 +1,84 @@
+package nullable
+
+import (
+	"encoding/xml"
+	"reflect"
+)
+
+// Empty elements and attributes are decoded as null, unless the underlying
+// type is a string, in which case they are decoded as an empty string.
+
+// XMLOmitNull tells whether null values are omitted when marshaling XML
+// elements, instead of being emitted with the xsi:nil="true" attribute. Null
+// attributes are always omitted.
+var XMLOmitNull = false
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+var xmlNilAttrs = []xml.Attr{
+	{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+	{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+}
+
+func marshalXML(e *xml.Encoder, start xml.StartElement, v interface{}, valid bool) error {
+	if !valid {
+		if XMLOmitNull {
+			return nil
+		}
+		start.Attr = append(start.Attr, xmlNilAttrs...)
+		return e.EncodeElement("", start)
+	}
+	text, err := formatText(v)
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(string(text), start)
+}
+
+func unmarshalXML(d *xml.Decoder, start xml.StartElement, v interface{}, valid *bool, p NullPolicy) error {
+	if isXMLNil(start) {
+		*valid = false
+		return d.Skip()
+	}
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	return unmarshalXMLText(text, v, valid, p)
+}
+
+func marshalXMLAttr(name xml.Name, v interface{}, valid bool) (xml.Attr, error) {
+	if !valid {
+		return xml.Attr{}, nil
+	}
+	text, err := formatText(v)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+func unmarshalXMLAttr(attr xml.Attr, v interface{}, valid *bool, p NullPolicy) error {
+	return unmarshalXMLText(attr.Value, v, valid, p)
+}
+
+func unmarshalXMLText(text string, v interface{}, valid *bool, p NullPolicy) error {
+	if text == "" && reflect.ValueOf(v).Elem().Kind() != reflect.String {
+		*valid = false
+		return nil
+	}
+	if err := parseText([]byte(text), v); err != nil {
+		return err
+	}
+	*valid = !p.nulls(reflect.ValueOf(v).Elem().Interface())
+	return nil
+}
+
+func isXMLNil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") {
+			return attr.Value == "true" || attr.Value == "1"
+		}
+	}
+	return false
+}
@@ -0,0 +1,238 @@
+package nullable_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/diegohordi/nullable"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type xmlRecord struct {
+	XMLName xml.Name                `xml:"record"`
+	ID      nullable.Int64          `xml:"id,attr"`
+	Name    nullable.String         `xml:"name"`
+	Active  nullable.Bool           `xml:"active"`
+	Score   nullable.Float64        `xml:"score"`
+	Born    nullable.Time           `xml:"born"`
+	Count   nullable.Uint8          `xml:"count"`
+	Status  nullable.Of[status]     `xml:"status"`
+	Note    nullable.OptionalString `xml:"note"`
+}
+
+func TestXML_Marshal(t *testing.T) {
+	const nilAttrs = `xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"`
+	tests := []struct {
+		name    string
+		omit    bool
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "should return nil elements and omit null attributes",
+			value: xmlRecord{},
+			want: fmt.Sprintf(
+				`<record><name %[1]s></name><active %[1]s></active><score %[1]s></score><born %[1]s></born>`+
+					`<count %[1]s></count><status %[1]s></status></record>`,
+				nilAttrs,
+			),
+			wantErr: false,
+		},
+		{
+			name:    "should omit null elements",
+			omit:    true,
+			value:   xmlRecord{Note: nullable.OptionalString{Set: true}},
+			want:    `<record></record>`,
+			wantErr: false,
+		},
+		{
+			name: "should return the given values",
+			omit: true,
+			value: xmlRecord{
+				ID:     *nullable.NewInt64(100),
+				Name:   *nullable.NewString(""),
+				Active: *nullable.NewBool(true),
+				Score:  *nullable.NewFloat64(1.5),
+				Born:   *nullable.NewTime(timeRef),
+				Count:  *nullable.NewUint8(3),
+				Status: *nullable.New(status("active")),
+				Note:   *nullable.NewOptionalString("test"),
+			},
+			want: fmt.Sprintf(
+				`<record id="100"><name></name><active>true</active><score>1.5</score><born>%s</born>`+
+					`<count>3</count><status>active</status><note>test</note></record>`,
+				timeRefStr,
+			),
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to an unsupported type",
+			value:   struct{ Value nullable.Of[[]int] }{Value: *nullable.New([]int{1})},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(omit bool) { nullable.XMLOmitNull = omit }(nullable.XMLOmitNull)
+			nullable.XMLOmitNull = tt.omit
+			got, err := xml.Marshal(tt.value)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalXML() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalXML() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestXML_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    xmlRecord
+		wantErr bool
+	}{
+		{
+			name: "should unmarshal nil and empty elements as null",
+			data: `<record xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+				`<name xsi:nil="true"/><active></active><born/><note xsi:nil="true"/></record>`,
+			want: xmlRecord{
+				XMLName: xml.Name{Local: "record"},
+				Note:    nullable.OptionalString{Set: true},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should unmarshal the given values",
+			data: fmt.Sprintf(
+				`<record id="100"><name></name><active>true</active><score>1.5</score><born>%s</born>`+
+					`<count>3</count><status>active</status><note>test</note></record>`,
+				timeRefStr,
+			),
+			want: xmlRecord{
+				XMLName: xml.Name{Local: "record"},
+				ID:      *nullable.NewInt64(100),
+				Name:    *nullable.NewString(""),
+				Active:  *nullable.NewBool(true),
+				Score:   *nullable.NewFloat64(1.5),
+				Born:    *nullable.NewTime(timeRef),
+				Count:   *nullable.NewUint8(3),
+				Status:  *nullable.New(status("active")),
+				Note:    *nullable.NewOptionalString("test"),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error due to an unexpected element value",
+			data:    `<record><count>256</count></record>`,
+			wantErr: true,
+		},
+		{
+			name:    "should return an error due to an unexpected attribute value",
+			data:    `<record id="test"></record>`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got xmlRecord
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if err != nil && tt.wantErr {
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalXML() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Born.Time.Equal(tt.want.Born.Time) {
+				t.Errorf("UnmarshalXML() got = %v, want %v", got.Born, tt.want.Born)
+			}
+			got.Born.Time, tt.want.Born.Time = time.Time{}, time.Time{}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalXML() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestXML_Encoding(t *testing.T) {
+	name := xml.Name{Local: "v"}
+	for _, tt := range encodingTests(t) {
+		t.Run(tt.name, func(t *testing.T) {
+			var want strings.Builder
+			if err := xml.EscapeText(&want, []byte(tt.text)); err != nil {
+				t.Fatalf("EscapeText() error = %v", err)
+			}
+			var got strings.Builder
+			e := xml.NewEncoder(&got)
+			if err := e.EncodeElement(tt.value, xml.StartElement{Name: name}); err != nil {
+				t.Errorf("MarshalXML() error = %v", err)
+				return
+			}
+			if got.String() != "<v>"+want.String()+"</v>" {
+				t.Errorf("MarshalXML() got = %s, want <v>%s</v>", got.String(), want.String())
+			}
+			n := reflect.New(reflect.TypeOf(tt.value))
+			if err := xml.Unmarshal([]byte(got.String()), n.Interface()); err != nil {
+				t.Errorf("UnmarshalXML() error = %v", err)
+				return
+			}
+			if text, _ := n.Elem().Interface().(encodedValue).MarshalText(); string(text) != tt.text {
+				t.Errorf("UnmarshalXML() got = %s, want %s", text, tt.text)
+			}
+			attr, err := tt.value.MarshalXMLAttr(name)
+			if err != nil || attr != (xml.Attr{Name: name, Value: tt.text}) {
+				t.Errorf("MarshalXMLAttr() got = %v, error = %v, want %s", attr, err, tt.text)
+			}
+			n = reflect.New(reflect.TypeOf(tt.value))
+			if err := n.Interface().(xml.UnmarshalerAttr).UnmarshalXMLAttr(attr); err != nil {
+				t.Errorf("UnmarshalXMLAttr() error = %v", err)
+				return
+			}
+			if text, _ := n.Elem().Interface().(encodedValue).MarshalText(); string(text) != tt.text {
+				t.Errorf("UnmarshalXMLAttr() got = %s, want %s", text, tt.text)
+			}
+		})
+	}
+}
+
+func TestXML_EncodingNull(t *testing.T) {
+	const want = `<v xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></v>`
+	name := xml.Name{Local: "v"}
+	for _, tt := range encodingTests(t) {
+		t.Run(tt.name, func(t *testing.T) {
+			null := reflect.Zero(reflect.TypeOf(tt.value)).Interface().(encodedValue)
+			var got strings.Builder
+			e := xml.NewEncoder(&got)
+			if err := e.EncodeElement(null, xml.StartElement{Name: name}); err != nil {
+				t.Errorf("MarshalXML() error = %v", err)
+				return
+			}
+			if got.String() != want {
+				t.Errorf("MarshalXML() got = %s, want %s", got.String(), want)
+			}
+			n := reflect.New(reflect.TypeOf(tt.value))
+			if err := xml.Unmarshal([]byte(got.String()), n.Interface()); err != nil {
+				t.Errorf("UnmarshalXML() error = %v", err)
+				return
+			}
+			if v, _ := n.Elem().Interface().(encodedValue).Value(); v != nil {
+				t.Errorf("UnmarshalXML() got = %v, want null", v)
+			}
+			if attr, err := null.MarshalXMLAttr(name); err != nil || attr != (xml.Attr{}) {
+				t.Errorf("MarshalXMLAttr() got = %v, error = %v, want no attribute", attr, err)
+			}
+			if err := n.Interface().(xml.UnmarshalerAttr).UnmarshalXMLAttr(xml.Attr{Name: name}); err != nil {
+				t.Errorf("UnmarshalXMLAttr() error = %v", err)
+			}
+		})
+	}
+}